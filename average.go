@@ -0,0 +1,23 @@
+package rx
+
+// Average returns an Observable that emits the arithmetic mean of all values
+// emitted by the source Observable when it completes. The mean is updated
+// incrementally as a float64, so it will not overflow for large integer
+// streams. For an empty source, the Observable completes without emitting.
+func Average[T Integer | Float](observable Observable[T]) Observable[float64] {
+	return func(observe Observer[float64], scheduler Scheduler, subscriber Subscriber) {
+		var count int
+		var mean float64
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				count++
+				mean += (float64(next) - mean) / float64(count)
+			case err != nil || count == 0:
+				observe(0, err, true)
+			default:
+				Of(mean)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
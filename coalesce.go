@@ -0,0 +1,111 @@
+package rx
+
+import "sync"
+
+// Coalesce returns an Observable that shares a single in-flight subscription
+// per key among all concurrent subscribers. On every subscription the key
+// function is called to determine the key. When no Observable is in flight for
+// that key, the factory is called to create one and it is shared with every
+// subscriber that arrives while it is still running. Like Share, a subscriber
+// that joins a flight late only receives the values emitted from then on.
+//
+// Once the shared Observable completes, errors or loses its last subscriber it
+// is forgotten, so the next subscription for the same key will call the
+// factory again. This makes Coalesce behave like a singleflight for
+// Observables and is useful to prevent duplicate backend calls when many
+// subscribers ask for the same resource at the same time.
+//
+// The shared Observable is subscribed on the Goroutine scheduler, so it does
+// not depend on the scheduler of any of its subscribers. Every subscriber
+// receives the values on its own scheduler through a queue of its own, so a
+// slow subscriber never holds up the others and subscribers calling from
+// different goroutines can each simply Wait on their own trampoline.
+func Coalesce[K comparable, T any](key func() K, factory func(K) Observable[T]) Observable[T] {
+	type flight struct {
+		sync.Mutex
+		mailboxes []*mailbox[T]
+		err       error
+		done      bool
+		source    Subscription
+	}
+	var inflight struct {
+		sync.Mutex
+		flights map[K]*flight
+	}
+	inflight.flights = make(map[K]*flight)
+	forget := func(k K, f *flight) {
+		inflight.Lock()
+		if inflight.flights[k] == f {
+			delete(inflight.flights, k)
+		}
+		inflight.Unlock()
+	}
+	takeoff := func(k K, f *flight) {
+		observer := func(next T, err error, done bool) {
+			if done {
+				forget(k, f)
+			}
+			f.Lock()
+			if done {
+				f.err, f.done = err, true
+			}
+			mailboxes := f.mailboxes
+			f.Unlock()
+			for _, m := range mailboxes {
+				m.send(next, err, done)
+			}
+		}
+		source := factory(k).Subscribe(observer, Goroutine)
+		f.Lock()
+		f.source = source
+		abandoned := (len(f.mailboxes) == 0 && !f.done)
+		f.Unlock()
+		if abandoned {
+			source.Unsubscribe()
+		}
+	}
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		k := key()
+		inflight.Lock()
+		f, ok := inflight.flights[k]
+		if !ok {
+			f = &flight{}
+			inflight.flights[k] = f
+		}
+		m := newMailbox[T]()
+		f.Lock()
+		if f.done {
+			var zero T
+			m.send(zero, f.err, true)
+		} else {
+			f.mailboxes = append(f.mailboxes[:len(f.mailboxes):len(f.mailboxes)], m)
+		}
+		f.Unlock()
+		inflight.Unlock()
+		subscriber.OnUnsubscribe(func() {
+			inflight.Lock()
+			f.Lock()
+			var mailboxes []*mailbox[T]
+			for _, other := range f.mailboxes {
+				if other != m {
+					mailboxes = append(mailboxes, other)
+				}
+			}
+			f.mailboxes = mailboxes
+			last := (len(mailboxes) == 0 && !f.done)
+			source := f.source
+			f.Unlock()
+			if last && inflight.flights[k] == f {
+				delete(inflight.flights, k)
+			}
+			inflight.Unlock()
+			if last && source != nil {
+				source.Unsubscribe()
+			}
+		})
+		m.deliver(observe, scheduler, subscriber)
+		if !ok {
+			takeoff(k, f)
+		}
+	}
+}
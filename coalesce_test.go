@@ -0,0 +1,97 @@
+package rx_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestCoalesce(t *testing.T) {
+	const callers = 8
+
+	t.Run("Concurrent callers share a single fetch", func(t *testing.T) {
+		var subscribed, fetches atomic.Int32
+		release := make(chan string)
+		key := func() string {
+			if subscribed.Add(1) == callers {
+				// give the last caller ample time to join the fetch in flight.
+				time.AfterFunc(100*time.Millisecond, func() { close(release) })
+			}
+			return "user/42"
+		}
+		fetch := rx.Coalesce(key, func(key string) rx.Observable[string] {
+			fetches.Add(1)
+			// hold the fetch in flight until every caller has subscribed.
+			return rx.Concat(rx.Recv(release), rx.Of("content of "+key))
+		})
+
+		var wg sync.WaitGroup
+		results := make([][]string, callers)
+		errs := make([]error, callers)
+		for i := range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], errs[i] = fetch.Slice()
+			}()
+		}
+		wg.Wait()
+
+		for i := range callers {
+			if errs[i] != nil {
+				t.Fatalf("caller %d: unexpected error %v", i, errs[i])
+			}
+			if len(results[i]) != 1 || results[i][0] != "content of user/42" {
+				t.Fatalf("caller %d: got %v", i, results[i])
+			}
+		}
+		if n := fetches.Load(); n != 1 {
+			t.Fatalf("expected 1 fetch, got %d", n)
+		}
+	})
+	t.Run("Late subscriber only receives new values", func(t *testing.T) {
+		gate := make(chan int)
+		fetch := rx.Coalesce(func() string { return "counter" }, func(string) rx.Observable[int] {
+			return rx.Concat(rx.Of(1), rx.Recv(gate))
+		})
+
+		first := make(chan int, 1)
+		var a []int
+		observeA := func(next int, err error, done bool) {
+			if !done {
+				a = append(a, next)
+				if len(a) == 1 {
+					first <- next
+				}
+			}
+		}
+		subA := fetch.Subscribe(observeA, rx.Goroutine)
+		<-first
+
+		var b []int
+		observeB := func(next int, err error, done bool) {
+			if !done {
+				b = append(b, next)
+			}
+		}
+		subB := fetch.Subscribe(observeB, rx.Goroutine)
+
+		gate <- 2
+		close(gate)
+		if err := subA.Wait(); err != nil {
+			t.Fatalf("A: unexpected error %v", err)
+		}
+		if err := subB.Wait(); err != nil {
+			t.Fatalf("B: unexpected error %v", err)
+		}
+		if len(a) != 2 || a[0] != 1 || a[1] != 2 {
+			t.Fatalf("A: expected [1 2], got %v", a)
+		}
+		if len(b) != 1 || b[0] != 2 {
+			t.Fatalf("B: expected [2], got %v", b)
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package rx
+
+// Contains returns an Observable that emits true as soon as the source
+// Observable emits a value equal to value, then completes and unsubscribes
+// from the source. When the source completes without emitting such a value, it
+// emits false.
+func Contains[T comparable](observable Observable[T], value T) Observable[bool] {
+	return observable.Some(func(next T) bool { return next == value })
+}
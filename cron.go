@@ -0,0 +1,238 @@
+package rx
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCronSpec is returned by ParseCron and emitted by Cron when the
+// cron expression cannot be parsed.
+var ErrInvalidCronSpec = errors.Join(Err, errors.New("invalid cron spec"))
+
+// CronSchedule is a parsed cron expression that calculates the instants at
+// which it is due in a specific location.
+type CronSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+	restrictedDays                        bool // both dom and dow are restricted
+	loc                                   *time.Location
+}
+
+type cronField struct {
+	min, max int
+	names    []string
+}
+
+var (
+	cronSeconds = cronField{0, 59, nil}
+	cronMinutes = cronField{0, 59, nil}
+	cronHours   = cronField{0, 23, nil}
+	cronDoms    = cronField{1, 31, nil}
+	cronMonths  = cronField{1, 12, []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	cronDows    = cronField{0, 7, []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a standard cron expression with 5 fields (minute, hour,
+// day of month, month and day of week) or 6 fields (second first). Fields
+// support *, lists (1,15), ranges (1-5), steps (*/15 or 0-30/10) and the
+// names JAN-DEC and SUN-SAT. The descriptors @yearly, @monthly, @weekly,
+// @daily and @hourly are supported as well. When both the day of month and
+// day of week fields are restricted, a day matches when either one matches.
+//
+// The schedule is evaluated in loc, when loc is nil time.Local is used.
+func ParseCron(spec string, loc *time.Location) (*CronSchedule, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	if descriptor, ok := cronDescriptors[strings.ToLower(strings.TrimSpace(spec))]; ok {
+		spec = descriptor
+	}
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("%w: %q needs 5 or 6 fields", ErrInvalidCronSpec, spec)
+	}
+	schedule := &CronSchedule{loc: loc}
+	targets := []*uint64{&schedule.second, &schedule.minute, &schedule.hour, &schedule.dom, &schedule.month, &schedule.dow}
+	for i, field := range []cronField{cronSeconds, cronMinutes, cronHours, cronDoms, cronMonths, cronDows} {
+		bits, err := field.parse(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q %v", ErrInvalidCronSpec, spec, err)
+		}
+		*targets[i] = bits
+	}
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1 // 7 is also sunday
+	}
+	unrestricted := func(field string) bool { return field[0] == '*' || field[0] == '?' }
+	schedule.restrictedDays = !unrestricted(fields[3]) && !unrestricted(fields[5])
+	return schedule, nil
+}
+
+func (f cronField) parse(field string) (bits uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		lo, hi, step := f.min, f.max, 1
+		expr, stepExpr, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+		if expr != "*" && expr != "?" {
+			first, last, isRange := strings.Cut(expr, "-")
+			if lo, err = f.value(first); err != nil {
+				return 0, err
+			}
+			switch {
+			case isRange:
+				if hi, err = f.value(last); err != nil {
+					return 0, err
+				}
+			case !hasStep:
+				hi = lo
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(expr string) (int, error) {
+	for i, name := range f.names {
+		if name != "" && strings.EqualFold(expr, name) {
+			return i, nil
+		}
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q", expr)
+	}
+	return v, nil
+}
+
+// Next returns the first instant after the given time at which the schedule
+// is due, or the zero time when it is not due within the next 5 years.
+//
+// The schedule is matched against the wall clock of its location, so daylight
+// saving time transitions are handled as follows. A wall clock time skipped by
+// a spring forward transition is due at the corresponding instant after the
+// transition (e.g. 02:30 becomes 03:30). Wall clock times repeated by a fall
+// back transition are only due once, on their first occurrence, unless the
+// schedule matches every hour. Such a schedule keeps following real time, so
+// "*/15 * * * *" is due at 01:00, 01:15, 01:30 and 01:45 both before and
+// after the clocks fall back.
+func (s *CronSchedule) Next(after time.Time) time.Time {
+	next := s.next(after, s.loc)
+	if s.hour == 1<<24-1 {
+		// every hour matches, so also follow the wall clock of the offset in
+		// effect at after, which continues through a repeated hour.
+		_, offset := after.In(s.loc).Zone()
+		due := s.next(after, time.FixedZone("", offset)).In(s.loc)
+		if !due.IsZero() && s.matches(due) && (next.IsZero() || due.Before(next)) {
+			next = due
+		}
+	}
+	return next
+}
+
+func (s *CronSchedule) next(after time.Time, loc *time.Location) time.Time {
+	after = after.In(loc)
+	// wall clock arithmetic is done in UTC, which has no transitions.
+	wall := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute(), after.Second(), 0, time.UTC)
+	wall = wall.Add(time.Second)
+	limit := wall.AddDate(5, 0, 0)
+	for wall.Before(limit) {
+		y, mo, d := wall.Date()
+		h, mi, sec := wall.Clock()
+		switch {
+		case s.month&(1<<mo) == 0:
+			wall = time.Date(y, mo+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(wall):
+			wall = time.Date(y, mo, d+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<h) == 0:
+			wall = time.Date(y, mo, d, h+1, 0, 0, 0, time.UTC)
+		case s.minute&(1<<mi) == 0:
+			wall = time.Date(y, mo, d, h, mi+1, 0, 0, time.UTC)
+		case s.second&(1<<sec) == 0:
+			wall = wall.Add(time.Second)
+		default:
+			due := time.Date(y, mo, d, h, mi, sec, 0, loc)
+			if due.Hour() != h || due.Minute() != mi {
+				// skipped by a transition, shift by the offset in effect before it.
+				_, offset := due.Add(-12 * time.Hour).Zone()
+				due = wall.Add(-time.Duration(offset) * time.Second).In(loc)
+			}
+			if due.After(after) {
+				return due
+			}
+			wall = wall.Add(time.Second)
+		}
+	}
+	return time.Time{}
+}
+
+func (s *CronSchedule) matches(t time.Time) bool {
+	return s.month&(1<<t.Month()) != 0 && s.dayMatches(t) && s.hour&(1<<t.Hour()) != 0 &&
+		s.minute&(1<<t.Minute()) != 0 && s.second&(1<<t.Second()) != 0
+}
+
+func (s *CronSchedule) dayMatches(wall time.Time) bool {
+	dom := s.dom&(1<<wall.Day()) != 0
+	dow := s.dow&(1<<wall.Weekday()) != 0
+	if s.restrictedDays {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// Cron creates an Observable that emits the instants at which the cron
+// expression spec is due in location loc, see ParseCron for the supported
+// syntax and CronSchedule.Next for the handling of daylight saving time
+// transitions. Every instant is scheduled using the scheduler's time. When
+// spec cannot be parsed, the Observable emits an ErrInvalidCronSpec error.
+func Cron(spec string, loc *time.Location) Observable[time.Time] {
+	schedule, err := ParseCron(spec, loc)
+	if err != nil {
+		return Throw[time.Time](err)
+	}
+	return func(observe Observer[time.Time], scheduler Scheduler, subscriber Subscriber) {
+		due := schedule.Next(scheduler.Now())
+		if due.IsZero() {
+			Empty[time.Time]()(observe, scheduler, subscriber)
+			return
+		}
+		runner := scheduler.ScheduleFutureRecursive(due.Sub(scheduler.Now()), func(again func(time.Duration)) {
+			if subscriber.Subscribed() {
+				observe(due, nil, false)
+				if subscriber.Subscribed() {
+					if due = schedule.Next(due); !due.IsZero() {
+						again(due.Sub(scheduler.Now()))
+					} else {
+						observe(time.Time{}, nil, true)
+					}
+				}
+			}
+		})
+		subscriber.OnUnsubscribe(runner.Cancel)
+	}
+}
@@ -0,0 +1,106 @@
+package rx_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestCron(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	next := func(t *testing.T, spec string, after time.Time) time.Time {
+		t.Helper()
+		schedule, err := rx.ParseCron(spec, after.Location())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return schedule.Next(after)
+	}
+
+	t.Run("Fields", func(t *testing.T) {
+		tests := []struct {
+			spec     string
+			after    time.Time
+			expected time.Time
+		}{
+			{"*/15 * * * *", time.Date(2024, 1, 1, 10, 7, 0, 0, time.UTC), time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC)},
+			{"0 9 * * MON-FRI", time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)},
+			{"30 0 9 1,15 * *", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 15, 9, 0, 30, 0, time.UTC)},
+			{"0 0 29 feb *", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+			{"0 0 13 * 5", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+			{"@monthly", time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, test := range tests {
+			if actual := next(t, test.spec, test.after); !actual.Equal(test.expected) {
+				t.Errorf("%q after %v: expected %v, got %v", test.spec, test.after, test.expected, actual)
+			}
+		}
+	})
+
+	t.Run("Spring forward", func(t *testing.T) {
+		// 2024-03-10 02:00 EST clocks jump to 03:00 EDT, 02:30 does not exist.
+		after := time.Date(2024, 3, 10, 1, 0, 0, 0, newYork)
+		expected := time.Date(2024, 3, 10, 3, 30, 0, 0, newYork)
+		if actual := next(t, "30 2 * * *", after); !actual.Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, actual)
+		}
+	})
+
+	t.Run("Fall back", func(t *testing.T) {
+		// 2024-11-03 02:00 EDT clocks fall back to 01:00 EST, 01:30 occurs twice.
+		first := next(t, "30 1 * * *", time.Date(2024, 11, 3, 0, 0, 0, 0, newYork))
+		if first.Hour() != 1 || first.Minute() != 30 || first.Day() != 3 {
+			t.Fatalf("Expected 01:30 on Nov 3, got %v", first)
+		}
+		second := next(t, "30 1 * * *", first)
+		if second.Day() != 4 || second.Hour() != 1 || second.Minute() != 30 {
+			t.Errorf("Expected 01:30 on Nov 4, got %v", second)
+		}
+	})
+
+	t.Run("Fall back every hour", func(t *testing.T) {
+		// a wildcard hour keeps firing in real time through the repeated hour.
+		edt, est := time.FixedZone("EDT", -4*3600), time.FixedZone("EST", -5*3600)
+		expected := []time.Time{
+			time.Date(2024, 11, 3, 1, 45, 0, 0, edt),
+			time.Date(2024, 11, 3, 1, 0, 0, 0, est),
+			time.Date(2024, 11, 3, 1, 15, 0, 0, est),
+			time.Date(2024, 11, 3, 1, 30, 0, 0, est),
+			time.Date(2024, 11, 3, 1, 45, 0, 0, est),
+			time.Date(2024, 11, 3, 2, 0, 0, 0, est),
+		}
+		after := time.Date(2024, 11, 3, 1, 30, 0, 0, edt).In(newYork)
+		for _, due := range expected {
+			actual := next(t, "*/15 * * * *", after)
+			if !actual.Equal(due) {
+				t.Fatalf("after %v: expected %v, got %v", after, due, actual)
+			}
+			after = actual
+		}
+	})
+
+	t.Run("Invalid spec", func(t *testing.T) {
+		for _, spec := range []string{"", "* * *", "60 * * * *", "* * * 13 *", "5-1 * * * *", "*/0 * * * *"} {
+			if _, err := rx.ParseCron(spec, time.UTC); !errors.Is(err, rx.ErrInvalidCronSpec) {
+				t.Errorf("%q: expected ErrInvalidCronSpec, got %v", spec, err)
+			}
+		}
+	})
+
+	t.Run("Observable", func(t *testing.T) {
+		start := time.Now()
+		ticks, err := rx.Cron("* * * * * *", time.UTC).Take(2).Slice()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(ticks) != 2 || ticks[1].Sub(ticks[0]) != time.Second || ticks[0].Before(start) {
+			t.Errorf("Expected 2 ticks a second apart, got %v", ticks)
+		}
+	})
+}
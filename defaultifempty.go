@@ -0,0 +1,28 @@
+package rx
+
+// DefaultIfEmpty returns an Observable that mirrors the source Observable, but
+// emits value when the source completes without emitting any values.
+func DefaultIfEmpty[T any](value T) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			empty := true
+			observable(func(next T, err error, done bool) {
+				switch {
+				case !done:
+					empty = false
+					observe(next, nil, false)
+				case err == nil && empty:
+					Of(value)(observe, scheduler, subscriber)
+				default:
+					observe(next, err, true)
+				}
+			}, scheduler, subscriber)
+		}
+	}
+}
+
+// DefaultIfEmpty mirrors the Observable, but emits value when it completes
+// without emitting any values.
+func (observable Observable[T]) DefaultIfEmpty(value T) Observable[T] {
+	return DefaultIfEmpty(value)(observable)
+}
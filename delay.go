@@ -5,6 +5,14 @@ import (
 	"time"
 )
 
+// Delay returns an Observable that delays every emission (values, error and
+// completion) of the source Observable by duration, keeping their order.
+//
+// Because every emission is delayed by the same duration, emissions become
+// due in the order they arrive. They are therefore kept in a FIFO queue and a
+// single timer is scheduled for the emission at the head of the queue. When
+// the queue runs empty no timer is scheduled, so an idle stream costs
+// nothing.
 func Delay[T any](duration time.Duration) Pipe[T] {
 	type emission[T any] struct {
 		at   time.Time
@@ -18,33 +26,49 @@ func Delay[T any](duration time.Duration) Pipe[T] {
 			var delay struct {
 				sync.Mutex
 				emissions []emission[T]
+				cancel    func()
 			}
-			delayer := scheduler.ScheduleFutureRecursive(duration, func(again func(time.Duration)) {
-				if subscriber.Subscribed() {
-					delay.Lock()
-					for _, entry := range delay.emissions {
+			delayer := func(again func(time.Duration)) {
+				if !subscriber.Subscribed() {
+					return
+				}
+				delay.Lock()
+				for len(delay.emissions) > 0 {
+					entry := delay.emissions[0]
+					if due := entry.at.Sub(scheduler.Now()); due > 0 {
 						delay.Unlock()
-						due := entry.at.Sub(scheduler.Now())
-						if due > 0 {
-							again(due)
-							return
-						}
-						observe(entry.next, entry.err, entry.done)
-						if entry.done || !subscriber.Subscribed() {
-							return
-						}
-						delay.Lock()
-						delay.emissions = delay.emissions[1:]
+						again(due)
+						return
 					}
+					delay.emissions = delay.emissions[1:]
 					delay.Unlock()
-					again(duration) // keep on rescheduling the emitter
+					observe(entry.next, entry.err, entry.done)
+					if entry.done || !subscriber.Subscribed() {
+						return
+					}
+					delay.Lock()
 				}
+				delay.emissions = nil
+				delay.cancel = nil
+				delay.Unlock()
+			}
+			subscriber.OnUnsubscribe(func() {
+				delay.Lock()
+				if delay.cancel != nil {
+					delay.cancel()
+					delay.cancel = nil
+				}
+				delay.Unlock()
 			})
-			subscriber.OnUnsubscribe(delayer.Cancel)
 			observer := func(next T, err error, done bool) {
 				delay.Lock()
-				delay.emissions = append(delay.emissions, emission[T]{scheduler.Now().Add(duration), next, err, done})
-				delay.Unlock()
+				defer delay.Unlock()
+				if subscriber.Subscribed() {
+					delay.emissions = append(delay.emissions, emission[T]{scheduler.Now().Add(duration), next, err, done})
+					if delay.cancel == nil {
+						delay.cancel = scheduler.ScheduleFutureRecursive(duration, delayer).Cancel
+					}
+				}
 			}
 			observable(observer, scheduler, subscriber)
 		}
@@ -0,0 +1,62 @@
+package rx_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestDelay(t *testing.T) {
+	const ms = time.Millisecond
+
+	t.Run("Burst keeps order", func(t *testing.T) {
+		const n = 10000
+		ch := make(chan int, n)
+		for i := 0; i < n; i++ {
+			ch <- i
+		}
+		close(ch)
+
+		results, err := rx.Recv(ch).Delay(5 * ms).Slice(rx.Goroutine)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(results) != n {
+			t.Fatalf("Expected %v items, got %v", n, len(results))
+		}
+		for i, v := range results {
+			if v != i {
+				t.Fatalf("Expected %v at index %v, got %v", i, i, v)
+			}
+		}
+	})
+
+	t.Run("Delays values and error", func(t *testing.T) {
+		start := time.Now()
+		var at []time.Duration
+		err := rx.Concat(rx.Of(1), rx.Throw[int](errors.New("failed"))).Delay(20 * ms).Tap(func(next int, err error, done bool) {
+			at = append(at, time.Since(start))
+		}).Wait()
+		if err == nil || err.Error() != "failed" {
+			t.Fatalf("Expected error failed, got %v", err)
+		}
+		for _, elapsed := range at {
+			if elapsed < 20*ms {
+				t.Errorf("Expected emission after 20ms, got %v", elapsed)
+			}
+		}
+	})
+
+	t.Run("Idle gaps between bursts", func(t *testing.T) {
+		source := rx.Concat(rx.From(1, 2), rx.Of(3).Delay(30*ms), rx.From(4, 5))
+		results, err := source.Delay(10 * ms).Slice()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(results) != 5 || results[0] != 1 || results[4] != 5 {
+			t.Errorf("Expected [1 2 3 4 5], got %v", results)
+		}
+	})
+}
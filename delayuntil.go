@@ -0,0 +1,19 @@
+package rx
+
+import "time"
+
+// DelayUntil returns an Observable that delays every value emitted by the
+// source Observable until the absolute time returned by due for that value,
+// using the scheduler's time. Values whose due time has passed are emitted
+// immediately. This allows emitting events at deadlines carried in the data.
+func DelayUntil[T any](due func(T) time.Time) Pipe[T] {
+	return DelayWhen(func(next T) Observable[any] {
+		return TimerAt(due(next)).AsObservable()
+	})
+}
+
+// DelayUntil delays every value emitted by the Observable until the absolute
+// time returned by due for that value.
+func (observable Observable[T]) DelayUntil(due func(T) time.Time) Observable[T] {
+	return DelayUntil(due)(observable)
+}
@@ -0,0 +1,72 @@
+package rx
+
+import "sync"
+
+// DelayWhen returns an Observable that delays every value emitted by the
+// source Observable until the Observable returned by delay for that value
+// emits its first value or completes. Values are therefore emitted in the
+// order their delays end, not necessarily in the order they were received.
+// Completion is delayed until all pending values have been emitted, errors
+// are emitted immediately.
+func DelayWhen[T any](delay func(T) Observable[any]) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			var delays struct {
+				sync.Mutex
+				active     int
+				sourceDone bool
+				done       bool
+			}
+			observer := func(next T, err error, done bool) {
+				delays.Lock()
+				defer delays.Unlock()
+				if delays.done {
+					return
+				}
+				switch {
+				case !done:
+					delays.active++
+					delayer := subscriber.Add()
+					delays.Unlock()
+					delay(next)(func(_ any, err error, done bool) {
+						delays.Lock()
+						defer delays.Unlock()
+						if delays.done || !delayer.Subscribed() {
+							return
+						}
+						delayer.Unsubscribe()
+						if err != nil {
+							delays.done = true
+							var zero T
+							observe(zero, err, true)
+							return
+						}
+						observe(next, nil, false)
+						if delays.active--; delays.active == 0 && delays.sourceDone {
+							delays.done = true
+							var zero T
+							observe(zero, nil, true)
+						}
+					}, scheduler, delayer)
+					delays.Lock()
+				case err != nil:
+					delays.done = true
+					observe(next, err, true)
+				default:
+					delays.sourceDone = true
+					if delays.active == 0 {
+						delays.done = true
+						observe(next, nil, true)
+					}
+				}
+			}
+			observable(observer, scheduler, subscriber)
+		}).AutoUnsubscribe()
+	}
+}
+
+// DelayWhen delays every value emitted by the Observable until the Observable
+// returned by delay for that value emits or completes.
+func (observable Observable[T]) DelayWhen(delay func(T) Observable[any]) Observable[T] {
+	return DelayWhen(delay)(observable)
+}
@@ -0,0 +1,118 @@
+package rx
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// DistinctOption is a function type used for configuring the memory of the
+// Distinct operator.
+type DistinctOption func(*distinctOptions)
+
+type distinctOptions struct {
+	capacity int
+	ttl      time.Duration
+	flush    Observable[any]
+}
+
+// WithDistinctCapacity creates a DistinctOption that limits the number of
+// remembered keys to n. When a new key would exceed the capacity, the least
+// recently seen key is forgotten.
+func WithDistinctCapacity(n int) DistinctOption {
+	return func(options *distinctOptions) {
+		options.capacity = n
+	}
+}
+
+// WithDistinctTTL creates a DistinctOption that forgets a key when it has not
+// been seen for the ttl duration, measured using the scheduler's time.
+func WithDistinctTTL(ttl time.Duration) DistinctOption {
+	return func(options *distinctOptions) {
+		options.ttl = ttl
+	}
+}
+
+// WithDistinctFlush creates a DistinctOption that forgets all keys every time
+// the notifier emits a value.
+func WithDistinctFlush(notifier Observable[any]) DistinctOption {
+	return func(options *distinctOptions) {
+		options.flush = notifier
+	}
+}
+
+// Distinct returns an Observable that emits only the values emitted by the
+// source Observable whose key has not been seen before. Unlike
+// DistinctUntilChanged, which only suppresses consecutive duplicates, Distinct
+// remembers every key it has seen. Use the options WithDistinctCapacity,
+// WithDistinctTTL and WithDistinctFlush to bound that memory.
+func Distinct[T any, K comparable](key func(T) K, options ...DistinctOption) Pipe[T] {
+	var opts distinctOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	type seen struct {
+		key K
+		at  time.Time
+	}
+	return func(observable Observable[T]) Observable[T] {
+		return Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			var distinct struct {
+				sync.Mutex
+				keys   map[K]*list.Element
+				recent list.List // least recently seen at the front
+				done   bool
+			}
+			distinct.keys = make(map[K]*list.Element)
+			observer := func(next T, err error, done bool) {
+				distinct.Lock()
+				defer distinct.Unlock()
+				if distinct.done {
+					return
+				}
+				if done {
+					distinct.done = true
+					observe(next, err, true)
+					return
+				}
+				now := scheduler.Now()
+				if opts.ttl > 0 {
+					for front := distinct.recent.Front(); front != nil; front = distinct.recent.Front() {
+						if now.Sub(front.Value.(seen).at) < opts.ttl {
+							break
+						}
+						delete(distinct.keys, distinct.recent.Remove(front).(seen).key)
+					}
+				}
+				k := key(next)
+				if element, ok := distinct.keys[k]; ok {
+					element.Value = seen{k, now}
+					distinct.recent.MoveToBack(element)
+					return
+				}
+				distinct.keys[k] = distinct.recent.PushBack(seen{k, now})
+				if opts.capacity > 0 && distinct.recent.Len() > opts.capacity {
+					delete(distinct.keys, distinct.recent.Remove(distinct.recent.Front()).(seen).key)
+				}
+				observe(next, nil, false)
+			}
+			if opts.flush != nil {
+				opts.flush(func(next any, err error, done bool) {
+					switch {
+					case !done:
+						distinct.Lock()
+						clear(distinct.keys)
+						distinct.recent.Init()
+						distinct.Unlock()
+					case err != nil:
+						var zero T
+						observer(zero, err, true)
+					}
+				}, scheduler, subscriber)
+			}
+			if subscriber.Subscribed() {
+				observable(observer, scheduler, subscriber)
+			}
+		}).AutoUnsubscribe()
+	}
+}
@@ -0,0 +1,46 @@
+package rx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestDistinct(t *testing.T) {
+	const ms = time.Millisecond
+	identity := func(next int) int { return next }
+
+	t.Run("Forget keys after ttl", func(t *testing.T) {
+		source := rx.Concat(rx.From(1, 1), rx.Of(1).Delay(50*ms))
+
+		short, err := source.Pipe(rx.Distinct(identity, rx.WithDistinctTTL(10*ms))).Slice()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(short) != 2 {
+			t.Errorf("Expected 2 items, got %v", short)
+		}
+
+		long, err := source.Pipe(rx.Distinct(identity, rx.WithDistinctTTL(time.Second))).Slice()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(long) != 1 {
+			t.Errorf("Expected 1 item, got %v", long)
+		}
+	})
+
+	t.Run("Forget keys on flush", func(t *testing.T) {
+		source := rx.Map(rx.Interval[int](20*ms).Take(5), func(int) int { return 7 })
+		flush := rx.Timer[int](50 * ms).AsObservable()
+
+		results, err := source.Pipe(rx.Distinct(identity, rx.WithDistinctFlush(flush))).Slice()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(results) != 2 {
+			t.Errorf("Expected 2 items, got %v", results)
+		}
+	})
+}
@@ -0,0 +1,7 @@
+package rx
+
+// DistinctUntilKeyChanged returns an Observable that only emits a value when
+// its key differs from the key of the previously emitted value.
+func DistinctUntilKeyChanged[T any, K comparable](key func(T) K) Pipe[T] {
+	return DistinctUntilChanged(func(a, b T) bool { return key(a) == key(b) })
+}
@@ -0,0 +1,27 @@
+package rx
+
+// Every returns an Observable that emits true when the predicate returns true
+// for all values emitted by the source Observable, or when the source is
+// empty. As soon as the predicate returns false, it emits false, completes and
+// unsubscribes from the source.
+func (observable Observable[T]) Every(predicate func(T) bool) Observable[bool] {
+	return Observable[bool](func(observe Observer[bool], scheduler Scheduler, subscriber Subscriber) {
+		answered := false
+		observable(func(next T, err error, done bool) {
+			if !answered {
+				switch {
+				case !done:
+					if !predicate(next) {
+						answered = true
+						observe(false, nil, false)
+						observe(false, nil, true)
+					}
+				case err != nil:
+					observe(false, err, true)
+				default:
+					Of(true)(observe, scheduler, subscriber)
+				}
+			}
+		}, scheduler, subscriber)
+	}).AutoUnsubscribe()
+}
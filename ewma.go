@@ -0,0 +1,37 @@
+package rx
+
+import "errors"
+
+// ErrInvalidAlpha is emitted by EWMA when alpha is not in the range (0, 1].
+var ErrInvalidAlpha = errors.Join(Err, errors.New("invalid alpha"))
+
+// EWMA returns an Observable that emits the exponentially weighted moving
+// average of the values emitted by the source Observable, updated for every
+// value. The first value seeds the average, every following value is weighed
+// by alpha, so values closer to 1 discount older values faster. Combine it
+// with SampleTime to emit estimates on a fixed cadence instead.
+//
+// If alpha is not in the range (0, 1], it returns an Observable that emits an
+// ErrInvalidAlpha error.
+func EWMA[T Integer | Float](observable Observable[T], alpha float64) Observable[float64] {
+	if alpha <= 0 || alpha > 1 {
+		return Throw[float64](ErrInvalidAlpha)
+	}
+	return func(observe Observer[float64], scheduler Scheduler, subscriber Subscriber) {
+		var average float64
+		initialized := false
+		observable(func(next T, err error, done bool) {
+			if !done {
+				if initialized {
+					average += alpha * (float64(next) - average)
+				} else {
+					average = float64(next)
+					initialized = true
+				}
+				observe(average, nil, false)
+			} else {
+				observe(0, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
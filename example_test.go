@@ -591,3 +591,651 @@ func Example_commandPattern() {
 	// {hello 4}
 	// {world 4}
 }
+
+func Example_coalesce() {
+	serial := rx.NewScheduler()
+
+	fetches := 0
+	fetch := rx.Coalesce(func() string { return "user/42" }, func(key string) rx.Observable[string] {
+		fetches++
+		return rx.Of("content of " + key).Delay(100 * time.Millisecond)
+	})
+
+	fetch.Println().Go(serial)
+	fetch.Println().Go(serial)
+	fetch.Println().Go(serial)
+	serial.Wait()
+	fmt.Println("fetches:", fetches)
+
+	// the shared fetch completed, so a new subscription fetches again
+	fetch.Println().Wait()
+	fmt.Println("fetches:", fetches)
+	// Output:
+	// content of user/42
+	// content of user/42
+	// content of user/42
+	// fetches: 1
+	// content of user/42
+	// fetches: 2
+}
+
+func Example_loader() {
+	const ms = time.Millisecond
+
+	batchFn := func(keys []int) rx.Observable[map[int]string] {
+		fmt.Println("batch", keys)
+		values := make(map[int]string)
+		for _, key := range keys {
+			values[key] = "value-" + strconv.Itoa(key)
+		}
+		return rx.Of(values)
+	}
+
+	loader := rx.NewLoader(batchFn, 10, 5*ms)
+
+	rx.Merge(loader.Load(1), loader.Load(2), loader.Load(1)).Println().Wait()
+
+	// cached values do not call batchFn
+	loader.Load(2).Println().Wait()
+	// Output:
+	// batch [1 2]
+	// value-1
+	// value-2
+	// value-1
+	// value-2
+}
+
+func Example_hedge() {
+	const ms = time.Millisecond
+
+	// the first lookup is slow, the hedged second lookup is fast.
+	latencies := []time.Duration{200 * ms, 10 * ms, 10 * ms}
+	attempt := 0
+	lookup := func() rx.Observable[string] {
+		latency := latencies[attempt]
+		attempt++
+		return rx.Of("response " + strconv.Itoa(attempt)).Delay(latency)
+	}
+
+	rx.Hedge(lookup, 20*ms, 3).Println().Wait()
+	fmt.Println("attempts:", attempt)
+	// Output:
+	// response 2
+	// attempts: 2
+}
+
+func Example_using() {
+	type File struct{ name string }
+
+	open := func() (*File, error) {
+		fmt.Println("open")
+		return &File{"data.txt"}, nil
+	}
+	read := func(f *File) rx.Observable[string] {
+		return rx.From("line 1 of "+f.name, "line 2 of "+f.name, "line 3 of "+f.name)
+	}
+	close := func(f *File) {
+		fmt.Println("close")
+	}
+
+	lines := rx.Using(open, read, close)
+
+	lines.Println().Wait()
+
+	// dispose also runs when the subscription is canceled early by Take.
+	lines.Take(1).Println().Wait()
+	// Output:
+	// open
+	// line 1 of data.txt
+	// line 2 of data.txt
+	// line 3 of data.txt
+	// close
+	// open
+	// line 1 of data.txt
+	// close
+}
+
+func Example_finalize() {
+	source := rx.From(1, 2, 3).Finalize(func() { fmt.Println("finalized") })
+
+	source.Println().Wait()
+
+	source.Take(1).Println().Wait()
+
+	fmt.Println(rx.Throw[int](rx.Err).Finalize(func() { fmt.Println("finalized") }).Wait())
+	// Output:
+	// 1
+	// 2
+	// 3
+	// finalized
+	// 1
+	// finalized
+	// finalized
+	// rx
+}
+
+func Example_takeUntil() {
+	const ms = time.Millisecond
+
+	stop := rx.Timer[int](250 * ms).AsObservable()
+
+	rx.Interval[int](100 * ms).TakeUntil(stop).Println().Wait()
+	// Output:
+	// 0
+	// 1
+}
+
+func Example_skipUntil() {
+	const ms = time.Millisecond
+
+	start := rx.Timer[int](250 * ms).AsObservable()
+
+	rx.Interval[int](100 * ms).Take(4).SkipUntil(start).Println().Wait()
+	// Output:
+	// 2
+	// 3
+}
+
+func Example_takeWhile() {
+	lessThan3 := func(next int) bool { return next < 3 }
+
+	rx.From(1, 2, 3, 4, 1).TakeWhile(lessThan3).Println().Wait()
+	fmt.Println("inclusive")
+	rx.From(1, 2, 3, 4, 1).TakeWhile(lessThan3, rx.WithInclusive()).Println().Wait()
+	// Output:
+	// 1
+	// 2
+	// inclusive
+	// 1
+	// 2
+	// 3
+}
+
+func Example_skipWhile() {
+	rx.From(1, 2, 3, 4, 1).SkipWhile(func(next int) bool { return next < 3 }).Println().Wait()
+	// Output:
+	// 3
+	// 4
+	// 1
+}
+
+func Example_takeLast() {
+	rx.From(1, 2, 3, 4, 5).TakeLast(2).Println().Wait()
+	fmt.Println("SkipLast")
+	rx.From(1, 2, 3, 4, 5).SkipLast(2).Println().Wait()
+	// Output:
+	// 4
+	// 5
+	// SkipLast
+	// 1
+	// 2
+	// 3
+}
+
+func Example_sum() {
+	source := rx.From(1, 2, 3, 4)
+
+	rx.Sum(source).Println().Wait()
+	rx.Average(source).Println().Wait()
+	rx.RunningSum(source).Println().Wait()
+
+	// integer overflow is reported instead of wrapping around
+	fmt.Println(rx.Sum(rx.From[int8](100, 20, 10)).Println().Wait() == rx.ErrSumOverflow)
+
+	// empty sources sum to 0, but have no average
+	rx.Sum(rx.Empty[int]()).Println().Wait()
+	rx.Average(rx.Empty[int]()).Println().Wait()
+	// Output:
+	// 10
+	// 2.5
+	// 1
+	// 3
+	// 6
+	// 10
+	// true
+	// 0
+}
+
+func Example_minMax() {
+	source := rx.From(3, 1, 4, 1, 5)
+
+	rx.Min(source).Println().Wait()
+	rx.Max(source).Println().Wait()
+	rx.RunningMinMax(source).Println().Wait()
+
+	words := rx.From("go", "rx", "observable", "map")
+	length := func(word string) int { return len(word) }
+	rx.MinBy(words, length).Println().Wait()
+	rx.MaxBy(words, length).Println().Wait()
+	// Output:
+	// 1
+	// 5
+	// {3 3}
+	// {1 3}
+	// {1 4}
+	// {1 4}
+	// {1 5}
+	// go
+	// observable
+}
+
+func Example_runningVariance() {
+	source := rx.From(2, 4, 4, 4, 5, 5, 7, 9)
+
+	moments, _ := rx.RunningVariance(source).Last()
+	fmt.Printf("count=%d mean=%.1f variance=%.3f\n", moments.Count, moments.Mean, moments.Variance)
+
+	rx.EWMA(rx.From(10, 20, 20, 20), 0.5).Println().Wait()
+	// Output:
+	// count=8 mean=5.0 variance=4.571
+	// 10
+	// 15
+	// 17.5
+	// 18.75
+}
+
+func Example_slidingWindow() {
+	const ms = time.Millisecond
+
+	source := rx.From(1, 5, 2, 8, 3, 4)
+
+	// aggregate the last 3 values, every 2 values
+	rx.SlidingWindowCount(source, 3, 2).Println().Wait()
+
+	// aggregate the values of the last second, every 50 milliseconds
+	window, _ := rx.SlidingWindowTime(source.ConcatWith(rx.Never[int]()), time.Second, 50*ms).First()
+	fmt.Println(window.Count, window.Sum, window.Min, window.Max, window.Mean())
+	// Output:
+	// {2 6 1 5}
+	// {3 15 2 8}
+	// {3 15 3 8}
+	// 6 23 1 8 3.8333333333333335
+}
+
+func Example_collect() {
+	words := rx.From("apple", "avocado", "banana", "blueberry", "cherry", "apple")
+
+	first := func(word string) byte { return word[0] }
+	length := func(word string) int { return len(word) }
+	identity := func(word string) string { return word }
+
+	lengths, _ := rx.CollectMap(words, identity, length)
+	fmt.Println(lengths)
+
+	rx.ToMultiMap(words, first, identity).Println().Wait()
+
+	set, _ := rx.CollectSet(words)
+	fmt.Println(len(set))
+
+	less := func(a, b string) bool { return a < b }
+	rx.Sorted(words, less).Println().Wait()
+
+	longer := func(a, b string) bool { return len(a) > len(b) }
+	longest, _ := rx.CollectTopK(words, 2, longer)
+	fmt.Println(longest)
+	// Output:
+	// map[apple:5 avocado:7 banana:6 blueberry:9 cherry:6]
+	// map[97:[apple avocado apple] 98:[banana blueberry] 99:[cherry]]
+	// 5
+	// [apple apple avocado banana blueberry cherry]
+	// [blueberry avocado]
+}
+
+func Example_every() {
+	source := rx.From(2, 4, 5, 6)
+	even := func(next int) bool { return next%2 == 0 }
+
+	source.Every(even).Println().Wait()
+	source.Some(even).Println().Wait()
+	rx.Contains(source, 5).Println().Wait()
+	source.IsEmpty().Println().Wait()
+	rx.Empty[int]().IsEmpty().Println().Wait()
+
+	rx.Empty[int]().DefaultIfEmpty(42).Println().Wait()
+	_, err := rx.Empty[int]().ThrowIfEmpty(nil).First()
+	fmt.Println(errors.Is(err, rx.ErrEmpty))
+
+	source.SequenceEqual(rx.From(2, 4, 5, 6), rx.Equal[int]()).Println().Wait()
+	source.SequenceEqual(rx.From(2, 4, 5), rx.Equal[int]()).Println().Wait()
+
+	// short-circuits and unsubscribes from a never ending source
+	rx.Interval[int](time.Millisecond).Some(func(next int) bool { return next == 3 }).Println().Wait()
+	// Output:
+	// false
+	// true
+	// true
+	// false
+	// true
+	// 42
+	// true
+	// true
+	// false
+	// true
+}
+
+func Example_distinct() {
+	type Message struct {
+		ID   int
+		Body string
+	}
+	id := func(m Message) int { return m.ID }
+
+	messages := rx.From(
+		Message{1, "hello"},
+		Message{2, "world"},
+		Message{1, "hello"},
+		Message{3, "again"},
+		Message{1, "hello"},
+		Message{2, "world"},
+	)
+
+	messages.Pipe(rx.Distinct(id)).Println().Wait()
+
+	// only remember the 2 most recently seen message IDs
+	fmt.Println("capacity 2")
+	messages.Pipe(rx.Distinct(id, rx.WithDistinctCapacity(2))).Println().Wait()
+	// Output:
+	// {1 hello}
+	// {2 world}
+	// {3 again}
+	// capacity 2
+	// {1 hello}
+	// {2 world}
+	// {3 again}
+	// {2 world}
+}
+
+func Example_pairwise() {
+	type Reading struct {
+		Sensor string
+		Value  int
+	}
+	sensor := func(r Reading) string { return r.Sensor }
+
+	readings := rx.From(Reading{"a", 1}, Reading{"a", 2}, Reading{"b", 3}, Reading{"a", 4})
+	readings.Pipe(rx.DistinctUntilKeyChanged(sensor)).Println().Wait()
+
+	delta := func(pair rx.Tuple2[int, int]) int { return pair.Second - pair.First }
+	rx.Map(rx.Pairwise(rx.From(1, 4, 9, 16)), delta).Println().Wait()
+	// Output:
+	// {a 1}
+	// {b 3}
+	// {a 4}
+	// 3
+	// 5
+	// 7
+}
+
+func Example_forkJoin() {
+	const ms = time.Millisecond
+
+	user := rx.Of("alice").Delay(20 * ms)
+	orders := rx.From(1, 2, 3).Delay(10 * ms)
+
+	rx.ForkJoin2(user, orders).Println().Wait()
+
+	first := rx.Throw[int](errors.New("first failed"))
+	second := rx.Throw[string](errors.New("second failed")).Delay(10 * ms)
+
+	fmt.Println(rx.ForkJoin2(first, second).Wait())
+	fmt.Println(rx.ForkJoin2(first, second, rx.WithJoinedErrors()).Wait())
+	// Output:
+	// {alice 3}
+	// first failed
+	// first failed
+	// second failed
+}
+
+func Example_expand() {
+	tree := map[string][]string{
+		"/":  {"/a", "/b"},
+		"/a": {"/a/1", "/a/2"},
+		"/b": {"/b/1"},
+	}
+	children := func(dir string) rx.Observable[string] {
+		return rx.From(tree[dir]...)
+	}
+
+	rx.Expand(rx.Of("/"), children, 1).Println().Wait()
+	fmt.Println("depth first")
+	rx.Expand(rx.Of("/"), children, 1, rx.WithDepthFirst()).Println().Wait()
+	// Output:
+	// /
+	// /a
+	// /b
+	// /a/1
+	// /a/2
+	// /b/1
+	// depth first
+	// /
+	// /a
+	// /a/1
+	// /a/2
+	// /b
+	// /b/1
+}
+
+func Example_mergeScan() {
+	const ms = time.Millisecond
+
+	// fetch simulates a network call that folds the next change into the state.
+	fetch := func(state string, change string) rx.Observable[string] {
+		return rx.Of(state + change).Delay(10 * ms)
+	}
+
+	changes := rx.From("a", "b", "c")
+
+	rx.MergeScan(changes, "", fetch, 1).Println().Wait()
+
+	// every change cancels the fetch of the previous change
+	rx.SwitchScan(changes, "", fetch).Println().Wait()
+	// Output:
+	// a
+	// ab
+	// abc
+	// c
+}
+
+func Example_range() {
+	rx.Range(5, 3).Println().Wait()
+
+	powersOfTwo := rx.Generate(1,
+		func(n int) bool { return n <= 16 },
+		func(n int) int { return n * 2 },
+		func(n int) string { return "power " + strconv.Itoa(n) })
+	powersOfTwo.Println().Wait()
+
+	fibonacci := rx.Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, true
+	})
+	fibonacci.Take(8).Println().Wait()
+	// Output:
+	// 5
+	// 6
+	// 7
+	// power 1
+	// power 2
+	// power 4
+	// power 8
+	// power 16
+	// 0
+	// 1
+	// 1
+	// 2
+	// 3
+	// 5
+	// 8
+	// 13
+}
+
+func Example_paginate() {
+	pages := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+
+	fetched := 0
+	fetch := func(cursor int) rx.Observable[rx.Page[int, string]] {
+		fetched++
+		return rx.Of(rx.Page[int, string]{
+			Items:   pages[cursor],
+			Next:    cursor + 1,
+			HasNext: cursor+1 < len(pages),
+		})
+	}
+
+	rx.Paginate(0, fetch).Println().Wait()
+	fmt.Println("fetched", fetched)
+
+	// only fetch the pages that are needed
+	fetched = 0
+	rx.Paginate(0, fetch).Take(3).Println().Wait()
+	fmt.Println("fetched", fetched)
+	// Output:
+	// a
+	// b
+	// c
+	// d
+	// e
+	// fetched 3
+	// a
+	// b
+	// c
+	// fetched 2
+}
+
+func Example_poll() {
+	const ms = time.Millisecond
+
+	responses := []string{"v1", "v1", "", "v2", "v2", "v3"}
+	calls := 0
+	fetch := func() (string, error) {
+		response := responses[calls]
+		calls++
+		if response == "" {
+			return "", errors.New("unavailable")
+		}
+		return response, nil
+	}
+	backoff := func(failures int) time.Duration {
+		return time.Duration(failures) * 5 * ms
+	}
+
+	rx.Poll(10*ms, fetch, rx.Equal[string](), rx.WithBackoff(backoff)).Take(3).Println().Wait()
+	fmt.Println("calls", calls)
+	// Output:
+	// v1
+	// v2
+	// v3
+	// calls 6
+}
+
+func Example_delayUntil() {
+	const ms = time.Millisecond
+
+	type Event struct {
+		Name     string
+		Deadline time.Time
+	}
+	deadline := func(e Event) time.Time { return e.Deadline }
+
+	now := time.Now()
+	events := rx.From(
+		Event{"late", now.Add(40 * ms)},
+		Event{"early", now.Add(10 * ms)},
+		Event{"overdue", now.Add(-10 * ms)},
+	)
+	rx.Map(events.DelayUntil(deadline), func(e Event) string { return e.Name }).Println().Wait()
+
+	at, _ := rx.TimerAt(now.Add(50 * ms)).First()
+	fmt.Println(!at.Before(now.Add(50 * ms)))
+	// Output:
+	// overdue
+	// early
+	// late
+	// true
+}
+
+func Example_timeInterval() {
+	const ms = time.Millisecond
+
+	steps := rx.Concat(rx.Of("a").Delay(10*ms), rx.Of("b").Delay(30*ms), rx.Of("c").Delay(20*ms))
+	delays := map[string]time.Duration{"a": 10 * ms, "b": 30 * ms, "c": 20 * ms}
+
+	elapsed := func(e rx.Elapsed[string]) string {
+		return fmt.Sprint(e.Value, " waited at least ", delays[e.Value], ": ", e.Elapsed >= delays[e.Value])
+	}
+	rx.Map(rx.TimeInterval(steps), elapsed).Println().Wait()
+
+	var previous time.Time
+	ordered := func(t rx.Timestamped[string]) string {
+		after := t.At.After(previous)
+		previous = t.At
+		return fmt.Sprint(t.Value, " stamped after previous: ", after)
+	}
+	rx.Map(rx.Timestamp(steps), ordered).Println().Wait()
+	// Output:
+	// a waited at least 10ms: true
+	// b waited at least 30ms: true
+	// c waited at least 20ms: true
+	// a stamped after previous: true
+	// b stamped after previous: true
+	// c stamped after previous: true
+}
+
+func Example_heartbeat() {
+	const ms = time.Millisecond
+
+	feed := rx.Concat(rx.From(1, 2), rx.Of(3).Delay(300*ms))
+
+	err := feed.Heartbeat(100 * ms).Println().Wait()
+	fmt.Println(errors.Is(err, rx.ErrStale))
+
+	serial := rx.NewScheduler()
+	values, status := rx.HeartbeatStatus(feed, 100*ms)
+	var received []int
+	var changes []rx.Liveness
+	values.Append(&received).Go(serial)
+	status.Append(&changes).Go(serial)
+	serial.Wait()
+	fmt.Println(received, changes)
+
+	feed.InjectHeartbeat(120*ms, 0).Println().Wait()
+	// Output:
+	// 1
+	// 2
+	// true
+	// [1 2 3] [stale alive]
+	// 1
+	// 2
+	// 0
+	// 0
+	// 3
+}
+
+func Example_partition() {
+	serial := rx.NewScheduler()
+	even, odd := rx.Partition(rx.From(1, 2, 3, 4, 5, 6), func(next int) bool { return next%2 == 0 })
+	var evens, odds []int
+	even.Append(&evens).Go(serial)
+	odd.Append(&odds).Go(serial)
+	serial.Wait()
+	fmt.Println("even", evens)
+	fmt.Println("odd", odds)
+
+	pairs := rx.Zip2(rx.From("a", "b", "c"), rx.From(1, 2, 3))
+	letters, numbers := rx.Unzip2(pairs)
+	serial = rx.NewScheduler()
+	var lettersSeen []string
+	var numbersSeen []int
+	letters.Append(&lettersSeen).Go(serial)
+	numbers.Append(&numbersSeen).Go(serial)
+	serial.Wait()
+	fmt.Println(lettersSeen, numbersSeen)
+
+	// the source has been started, so subscribing again is an error
+	err := letters.Wait()
+	fmt.Println(errors.Is(err, rx.ErrLateSubscription))
+	// Output:
+	// even [2 4 6]
+	// odd [1 3 5]
+	// [a b c] [1 2 3]
+	// true
+}
@@ -0,0 +1,169 @@
+package rx
+
+import "sync"
+
+// ExpandOption is a function type used for configuring the order in which
+// Expand traverses the values.
+type ExpandOption func(*expandOptions)
+
+type expandOptions struct {
+	depthFirst bool
+}
+
+// WithDepthFirst creates an ExpandOption that makes Expand traverse the values
+// depth first (pre-order) instead of breadth first.
+func WithDepthFirst() ExpandOption {
+	return func(options *expandOptions) {
+		options.depthFirst = true
+	}
+}
+
+// Expand returns an Observable that emits the values emitted by the source
+// Observable and recursively applies project to every emitted value, merging
+// the values emitted by the projected Observables into the output and
+// expanding those too. It completes when the source and all projected
+// Observables have completed. This is useful for crawling paginated APIs or
+// directory trees.
+//
+// At most concurrency projected Observables are subscribed to at the same
+// time, a concurrency of 0 or less means no limit. By default every value is
+// emitted as soon as it arrives and values are projected in first-in
+// first-out order, which traverses breadth first.
+//
+// When the WithDepthFirst option is passed, the values are emitted in
+// depth first pre-order instead. A value is then only emitted and projected
+// after all values emitted before it by the same Observable have been fully
+// expanded, so values arriving early are held back until it is their turn.
+func Expand[T any](observable Observable[T], project func(T) Observable[T], concurrency int, options ...ExpandOption) Observable[T] {
+	var opts expandOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	if opts.depthFirst {
+		return expandDepthFirst(observable, project, concurrency)
+	}
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		var expand struct {
+			sync.Mutex
+			done       bool
+			sourceDone bool
+			inner      int
+			pending    []T
+		}
+		var expander Observer[T]
+		launch := func() {
+			for {
+				expand.Lock()
+				if expand.done || len(expand.pending) == 0 || (concurrency > 0 && expand.inner >= concurrency) {
+					if !expand.done && expand.sourceDone && expand.inner == 0 && len(expand.pending) == 0 {
+						expand.done = true
+						var zero T
+						observe(zero, nil, true)
+					}
+					expand.Unlock()
+					return
+				}
+				next := expand.pending[0]
+				expand.pending = expand.pending[1:]
+				expand.inner++
+				expand.Unlock()
+				project(next).AutoUnsubscribe()(expander, scheduler, subscriber)
+			}
+		}
+		observer := func(source bool) Observer[T] {
+			return func(next T, err error, done bool) {
+				expand.Lock()
+				if !expand.done {
+					switch {
+					case !done:
+						observe(next, nil, false)
+						expand.pending = append(expand.pending, next)
+					case err != nil:
+						expand.done = true
+						observe(next, err, true)
+					case source:
+						expand.sourceDone = true
+					default:
+						expand.inner--
+					}
+				}
+				expand.Unlock()
+				launch()
+			}
+		}
+		expander = observer(false)
+		observable.AutoUnsubscribe()(observer(true), scheduler, subscriber)
+	}
+}
+
+func expandDepthFirst[T any](observable Observable[T], project func(T) Observable[T], concurrency int) Observable[T] {
+	// frame holds the values of the source or a projected Observable that
+	// have not been visited yet.
+	type frame struct {
+		values []T
+		done   bool
+	}
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		var expand struct {
+			sync.Mutex
+			done  bool
+			inner int
+			stack []*frame
+		}
+		var observer func(*frame, bool) Observer[T]
+		visit := func() {
+			for {
+				expand.Lock()
+				for n := len(expand.stack); n > 0 && expand.stack[n-1].done && len(expand.stack[n-1].values) == 0; n-- {
+					expand.stack = expand.stack[:n-1]
+				}
+				if !expand.done && len(expand.stack) == 0 {
+					expand.done = true
+					var zero T
+					observe(zero, nil, true)
+				}
+				if expand.done {
+					expand.Unlock()
+					return
+				}
+				top := expand.stack[len(expand.stack)-1]
+				if len(top.values) == 0 || (concurrency > 0 && expand.inner >= concurrency) {
+					expand.Unlock()
+					return
+				}
+				next := top.values[0]
+				top.values = top.values[1:]
+				observe(next, nil, false)
+				child := &frame{}
+				expand.stack = append(expand.stack, child)
+				expand.inner++
+				expand.Unlock()
+				project(next).AutoUnsubscribe()(observer(child, false), scheduler, subscriber)
+			}
+		}
+		observer = func(f *frame, source bool) Observer[T] {
+			return func(next T, err error, done bool) {
+				expand.Lock()
+				if !expand.done {
+					switch {
+					case !done:
+						f.values = append(f.values, next)
+					case err != nil:
+						expand.done = true
+						observe(next, err, true)
+					default:
+						f.done = true
+						if !source {
+							expand.inner--
+						}
+					}
+				}
+				expand.Unlock()
+				visit()
+			}
+		}
+		root := &frame{}
+		expand.stack = append(expand.stack, root)
+		observable.AutoUnsubscribe()(observer(root, true), scheduler, subscriber)
+	}
+}
@@ -0,0 +1,75 @@
+package rx
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrLateSubscription is emitted to a subscriber of an Observable returned by
+// Partition, UnzipN or HeartbeatStatus that subscribes after the shared source
+// has already been started.
+var ErrLateSubscription = errors.Join(Err, errors.New("late subscription"))
+
+// fanOut returns an Observable that shares a single subscription to the source
+// Observable among exactly count subscribers, like AutoConnect(count). The
+// source is subscribed on the scheduler of the subscriber that brings the
+// number of subscriptions up to count, so every subscriber receives every
+// value. A subscriber that arrives after that receives an ErrLateSubscription
+// error. When all subscribers have unsubscribed, the source is unsubscribed.
+//
+// Every subscriber receives the values through an unbounded queue of its own,
+// on its own scheduler. So a slow subscriber never holds up the source or the
+// other subscribers.
+func fanOut[T any](source Observable[T], count int) Observable[T] {
+	var fan struct {
+		sync.Mutex
+		mailboxes  []*mailbox[T]
+		subscribed int
+		connection *subscription
+	}
+	observer := func(next T, err error, done bool) {
+		fan.Lock()
+		mailboxes := fan.mailboxes
+		fan.Unlock()
+		for _, m := range mailboxes {
+			m.send(next, err, done)
+		}
+	}
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		fan.Lock()
+		if fan.subscribed == count {
+			fan.Unlock()
+			var zero T
+			observe(zero, ErrLateSubscription, true)
+			return
+		}
+		m := newMailbox[T]()
+		fan.mailboxes = append(fan.mailboxes[:len(fan.mailboxes):len(fan.mailboxes)], m)
+		fan.subscribed++
+		var connection *subscription
+		if fan.subscribed == count {
+			connection = newSubscription(scheduler)
+			fan.connection = connection
+		}
+		fan.Unlock()
+		subscriber.OnUnsubscribe(func() {
+			fan.Lock()
+			var mailboxes []*mailbox[T]
+			for _, other := range fan.mailboxes {
+				if other != m {
+					mailboxes = append(mailboxes, other)
+				}
+			}
+			fan.mailboxes = mailboxes
+			connection := fan.connection
+			fan.Unlock()
+			if len(mailboxes) == 0 && connection != nil {
+				connection.Unsubscribe()
+			}
+		})
+		m.deliver(observe, scheduler, subscriber)
+		if connection != nil && subscriber.Subscribed() {
+			source(observer, scheduler, connection)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package rx
+
+// Finalize returns a Pipe that calls the finalize function exactly once when
+// the subscription terminates. Unlike OnDone, which only sees termination
+// emitted by the source, Finalize also runs when the subscription is canceled
+// by a downstream operator like Take or by an explicit Unsubscribe.
+func Finalize[T any](finalize func()) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			subscriber = subscriber.Add()
+			subscriber.OnUnsubscribe(finalize)
+			observer := func(next T, err error, done bool) {
+				observe(next, err, done)
+				if done {
+					subscriber.Unsubscribe()
+				}
+			}
+			observable(observer, scheduler, subscriber)
+		}
+	}
+}
+
+// Finalize calls the finalize function exactly once when the subscription
+// completes, errors or is unsubscribed.
+func (observable Observable[T]) Finalize(finalize func()) Observable[T] {
+	return Finalize[T](finalize)(observable)
+}
@@ -0,0 +1,32 @@
+package rx
+
+// ForkJoin waits for all observables to complete and then emits a slice with
+// the last value of each. It fails fast on the first error. To collect all
+// errors instead, use ForkJoinAll(From(observables...), WithJoinedErrors()).
+func ForkJoin[T any](observables ...Observable[T]) Observable[[]T] {
+	return ForkJoinAll(From(observables...))
+}
+
+func ForkJoin2[T, U any](first Observable[T], second Observable[U], options ...ForkJoinOption) Observable[Tuple2[T, U]] {
+	return Map(ForkJoinAll(From(first.AsObservable(), second.AsObservable()), options...), func(next []any) Tuple2[T, U] {
+		return Tuple2[T, U]{next[0].(T), next[1].(U)}
+	})
+}
+
+func ForkJoin3[T, U, V any](first Observable[T], second Observable[U], third Observable[V], options ...ForkJoinOption) Observable[Tuple3[T, U, V]] {
+	return Map(ForkJoinAll(From(first.AsObservable(), second.AsObservable(), third.AsObservable()), options...), func(next []any) Tuple3[T, U, V] {
+		return Tuple3[T, U, V]{next[0].(T), next[1].(U), next[2].(V)}
+	})
+}
+
+func ForkJoin4[T, U, V, W any](first Observable[T], second Observable[U], third Observable[V], fourth Observable[W], options ...ForkJoinOption) Observable[Tuple4[T, U, V, W]] {
+	return Map(ForkJoinAll(From(first.AsObservable(), second.AsObservable(), third.AsObservable(), fourth.AsObservable()), options...), func(next []any) Tuple4[T, U, V, W] {
+		return Tuple4[T, U, V, W]{next[0].(T), next[1].(U), next[2].(V), next[3].(W)}
+	})
+}
+
+func ForkJoin5[T, U, V, W, X any](first Observable[T], second Observable[U], third Observable[V], fourth Observable[W], fifth Observable[X], options ...ForkJoinOption) Observable[Tuple5[T, U, V, W, X]] {
+	return Map(ForkJoinAll(From(first.AsObservable(), second.AsObservable(), third.AsObservable(), fourth.AsObservable(), fifth.AsObservable()), options...), func(next []any) Tuple5[T, U, V, W, X] {
+		return Tuple5[T, U, V, W, X]{next[0].(T), next[1].(U), next[2].(V), next[3].(W), next[4].(X)}
+	})
+}
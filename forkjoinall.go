@@ -0,0 +1,118 @@
+package rx
+
+import (
+	"errors"
+	"sync"
+)
+
+// ForkJoinOption is a function type used for configuring how ForkJoin handles
+// errors of its sources.
+type ForkJoinOption func(*forkJoinOptions)
+
+type forkJoinOptions struct {
+	joinErrors bool
+}
+
+// WithJoinedErrors creates a ForkJoinOption that makes ForkJoin wait for all
+// sources to terminate, instead of failing fast on the first error. All errors
+// are then emitted together, combined with errors.Join.
+func WithJoinedErrors() ForkJoinOption {
+	return func(options *forkJoinOptions) {
+		options.joinErrors = true
+	}
+}
+
+// ForkJoinAll flattens a higher order Observable by subscribing to all the
+// Observables it emits once it completes. When all of them have completed, it
+// emits a slice with the last value of every Observable and completes. When
+// one of them completes without emitting a value, it completes without
+// emitting. By default, the first error is emitted immediately and all other
+// Observables are unsubscribed.
+func ForkJoinAll[T any](observable Observable[Observable[T]], options ...ForkJoinOption) Observable[[]T] {
+	var opts forkJoinOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	return Observable[[]T](func(observe Observer[[]T], scheduler Scheduler, subscriber Subscriber) {
+		var sources []Observable[T]
+		var fork struct {
+			sync.Mutex
+			values   []T
+			assigned []bool
+			errs     []error
+			active   int
+			done     bool
+		}
+		makeObserver := func(sourceIndex int) Observer[T] {
+			return func(next T, err error, done bool) {
+				fork.Lock()
+				defer fork.Unlock()
+				if fork.done {
+					return
+				}
+				switch {
+				case !done:
+					fork.values[sourceIndex] = next
+					fork.assigned[sourceIndex] = true
+				case err != nil && !opts.joinErrors:
+					fork.done = true
+					observe(nil, err, true)
+				default:
+					if err != nil {
+						fork.errs = append(fork.errs, err)
+					}
+					if fork.active--; fork.active == 0 {
+						fork.done = true
+						switch {
+						case len(fork.errs) > 0:
+							observe(nil, errors.Join(fork.errs...), true)
+						case !allAssigned(fork.assigned):
+							observe(nil, nil, true)
+						default:
+							observe(fork.values, nil, false)
+							observe(nil, nil, true)
+						}
+					}
+				}
+			}
+		}
+		observer := func(next Observable[T], err error, done bool) {
+			switch {
+			case !done:
+				sources = append(sources, next)
+			case err != nil:
+				observe(nil, err, true)
+			default:
+				if len(sources) == 0 {
+					observe(nil, nil, true)
+					return
+				}
+				runner := scheduler.Schedule(func() {
+					if subscriber.Subscribed() {
+						numSources := len(sources)
+						fork.values = make([]T, numSources)
+						fork.assigned = make([]bool, numSources)
+						fork.active = numSources
+						for sourceIndex, source := range sources {
+							if !subscriber.Subscribed() {
+								return
+							}
+							source.AutoUnsubscribe()(makeObserver(sourceIndex), scheduler, subscriber)
+						}
+					}
+				})
+				subscriber.OnUnsubscribe(runner.Cancel)
+			}
+		}
+		observable(observer, scheduler, subscriber)
+	}).AutoUnsubscribe()
+}
+
+func allAssigned(assigned []bool) bool {
+	for _, ok := range assigned {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+package rx
+
+// Generate creates an Observable that runs a state driven loop, like a for
+// statement. Starting with the initial state, it emits resultSelector(state)
+// as long as condition(state) returns true, advancing the state with iterate
+// after every emission. Every subscription starts again from the initial state.
+func Generate[S, T any](initial S, condition func(S) bool, iterate func(S) S, resultSelector func(S) T) Observable[T] {
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		state := initial
+		task := func(again func()) {
+			if subscriber.Subscribed() {
+				if condition(state) {
+					observe(resultSelector(state), nil, false)
+					if subscriber.Subscribed() {
+						state = iterate(state)
+						again()
+					}
+				} else {
+					var zero T
+					observe(zero, nil, true)
+				}
+			}
+		}
+		runner := scheduler.ScheduleRecursive(task)
+		subscriber.OnUnsubscribe(runner.Cancel)
+	}
+}
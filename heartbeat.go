@@ -0,0 +1,171 @@
+package rx
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrStale is emitted by an Observable returned from Heartbeat when the source
+// Observable did not emit anything within the expected period.
+var ErrStale = errors.Join(Err, errors.New("stale"))
+
+// Liveness is the status of an Observable that is monitored by Heartbeat.
+type Liveness int
+
+const (
+	// Alive means the monitored Observable emitted within the expected period.
+	Alive Liveness = iota
+	// Stale means the monitored Observable did not emit within the expected period.
+	Stale
+)
+
+func (l Liveness) String() string {
+	switch l {
+	case Alive:
+		return "alive"
+	case Stale:
+		return "stale"
+	default:
+		return "unknown"
+	}
+}
+
+// Heartbeat returns a Pipe that monitors the liveness of an Observable by
+// expecting it to emit a value at least every expected period, starting at
+// the moment of subscription. When the period passes without a value, the
+// returned Observable emits an ErrStale error and the source is unsubscribed.
+// Use HeartbeatStatus to observe the liveness as a stream instead.
+func Heartbeat[T any](expected time.Duration) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			status := func(liveness Liveness) {
+				if liveness == Stale {
+					var zero T
+					observe(zero, ErrStale, true)
+				}
+			}
+			monitor(observable, expected, status)(observe, scheduler, subscriber)
+		}).AutoUnsubscribe()
+	}
+}
+
+// Heartbeat returns an Observable that monitors the liveness of the observable
+// by expecting it to emit a value at least every expected period. See the
+// Heartbeat function for the details.
+func (observable Observable[T]) Heartbeat(expected time.Duration) Observable[T] {
+	return Heartbeat[T](expected)(observable)
+}
+
+// HeartbeatStatus monitors the liveness of an Observable by expecting it to
+// emit a value at least every expected period, starting at the moment of
+// subscription. It returns the values of the source unchanged, alongside a
+// status Observable that emits every change in liveness. The status emits
+// Stale when the period passes without a value and Alive when a value arrives
+// after that. It completes or errors along with the source.
+//
+// The source is subscribed to only once, when both the values and the status
+// have been subscribed to, see Partition for the details. To observe only the
+// values, use Heartbeat instead.
+func HeartbeatStatus[T any](observable Observable[T], expected time.Duration) (Observable[T], Observable[Liveness]) {
+	pulses := fanOut(func(observe Observer[pulse[T]], scheduler Scheduler, subscriber Subscriber) {
+		status := func(liveness Liveness) {
+			observe(pulse[T]{liveness: liveness, status: true}, nil, false)
+		}
+		observer := func(next T, err error, done bool) {
+			observe(pulse[T]{value: next}, err, done)
+		}
+		monitor(observable, expected, status)(observer, scheduler, subscriber)
+	}, 2)
+	isValue := func(next pulse[T]) bool { return !next.status }
+	isStatus := func(next pulse[T]) bool { return next.status }
+	value := func(next pulse[T]) T { return next.value }
+	liveness := func(next pulse[T]) Liveness { return next.liveness }
+	return Map(pulses.Filter(isValue), value), Map(pulses.Filter(isStatus), liveness)
+}
+
+// pulse is either a value emitted by a monitored Observable or a change in its
+// liveness.
+type pulse[T any] struct {
+	value    T
+	liveness Liveness
+	status   bool
+}
+
+// monitor returns an Observable that emits the values of the source Observable
+// and calls status with Stale whenever the expected period passes without a
+// value and with Alive when a value arrives after that. Calls to status are
+// serialized with the emissions.
+func monitor[T any](observable Observable[T], expected time.Duration, status func(Liveness)) Observable[T] {
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		var serial sync.Mutex // serializes emissions
+		var beat struct {
+			sync.Mutex
+			done     bool
+			stale    bool
+			deadline time.Time
+			cancel   func()
+		}
+		watcher := func(again func(time.Duration)) {
+			serial.Lock()
+			defer serial.Unlock()
+			beat.Lock()
+			if beat.done || !subscriber.Subscribed() {
+				beat.Unlock()
+				return
+			}
+			if remaining := beat.deadline.Sub(scheduler.Now()); remaining > 0 {
+				beat.Unlock()
+				again(remaining)
+				return
+			}
+			beat.cancel = nil
+			beat.stale = true
+			beat.Unlock()
+			status(Stale)
+		}
+		watch := func() {
+			beat.deadline = scheduler.Now().Add(expected)
+			if beat.cancel == nil {
+				beat.cancel = scheduler.ScheduleFutureRecursive(expected, watcher).Cancel
+			}
+		}
+		unwatch := func() {
+			if beat.cancel != nil {
+				beat.cancel()
+				beat.cancel = nil
+			}
+		}
+		subscriber.OnUnsubscribe(func() {
+			beat.Lock()
+			unwatch()
+			beat.Unlock()
+		})
+		observer := func(next T, err error, done bool) {
+			serial.Lock()
+			defer serial.Unlock()
+			beat.Lock()
+			if beat.done || !subscriber.Subscribed() {
+				beat.Unlock()
+				return
+			}
+			recovered := beat.stale
+			beat.stale = false
+			if !done {
+				watch()
+			} else {
+				beat.done = true
+				unwatch()
+			}
+			beat.Unlock()
+			if recovered && !done {
+				status(Alive)
+			}
+			observe(next, err, done)
+		}
+		beat.Lock()
+		watch()
+		beat.Unlock()
+		observable(observer, scheduler, subscriber)
+	}
+}
@@ -0,0 +1,61 @@
+package rx_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestHeartbeatStatus(t *testing.T) {
+	const ms = time.Millisecond
+
+	feed := rx.Concat(rx.From(1, 2), rx.Of(3).Delay(300*ms))
+
+	t.Run("Values and status on a serial scheduler", func(t *testing.T) {
+		var received []int
+		var changes []rx.Liveness
+		values, status := rx.HeartbeatStatus(feed, 100*ms)
+		serial := rx.NewScheduler()
+		first := values.Append(&received).Go(serial)
+		second := status.Append(&changes).Go(serial)
+		serial.Wait()
+		if first.Err() != nil || second.Err() != nil {
+			t.Fatal(first.Err(), second.Err())
+		}
+		if len(received) != 3 {
+			t.Fatalf("expected [1 2 3], got %v", received)
+		}
+		if len(changes) != 2 || changes[0] != rx.Stale || changes[1] != rx.Alive {
+			t.Fatalf("expected [stale alive], got %v", changes)
+		}
+	})
+
+	t.Run("Concurrent values and status", func(t *testing.T) {
+		var received []int
+		var changes []rx.Liveness
+		values, status := rx.HeartbeatStatus(feed, 100*ms)
+		first := values.Append(&received).Go()
+		second := status.Append(&changes).Go()
+		if err := first.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if err := second.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if len(received) != 3 || len(changes) != 2 {
+			t.Fatalf("expected 3 values and 2 changes, got %v and %v", received, changes)
+		}
+	})
+	t.Run("Late subscription", func(t *testing.T) {
+		values, status := rx.HeartbeatStatus(rx.From(1, 2), 100*ms)
+		serial := rx.NewScheduler()
+		values.Go(serial)
+		status.Go(serial)
+		serial.Wait()
+		if err := status.Wait(); !errors.Is(err, rx.ErrLateSubscription) {
+			t.Fatalf("expected ErrLateSubscription, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package rx
+
+import (
+	"sync"
+	"time"
+)
+
+// Hedge returns an Observable that subscribes to an Observable created by the
+// factory and, when that has not emitted anything within delay, subscribes to
+// another copy created by the factory. This continues every delay until
+// maxAttempts copies are running. Like Race, the first copy to emit a value,
+// an error or a completion wins and all other copies are unsubscribed.
+//
+// Hedging trades some extra load for lower tail latency on lookups that are
+// usually fast but occasionally slow. If maxAttempts is less than 1, it
+// returns an Observable that emits an ErrInvalidCount error.
+//
+// The hedged copies may be launched from different goroutines when a
+// concurrent scheduler is used, but calls to the factory are serialized, so
+// the factory itself does not need to be safe for concurrent use.
+func Hedge[T any](factory func() Observable[T], delay time.Duration, maxAttempts int) Observable[T] {
+	if maxAttempts < 1 {
+		return Throw[T](ErrInvalidCount)
+	}
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		var hedge struct {
+			sync.Mutex
+			winner   Subscriber
+			attempts []Subscriber
+		}
+		var serial sync.Mutex
+		create := func() Observable[T] {
+			serial.Lock()
+			defer serial.Unlock()
+			return factory()
+		}
+		subscribe := func(attempt Subscriber) Observer[T] {
+			return func(next T, err error, done bool) {
+				hedge.Lock()
+				var losers []Subscriber
+				if hedge.winner == nil {
+					hedge.winner = attempt
+					for _, other := range hedge.attempts {
+						if other != attempt {
+							losers = append(losers, other)
+						}
+					}
+					hedge.attempts = nil
+				}
+				won := (hedge.winner == attempt)
+				hedge.Unlock()
+				for _, loser := range losers {
+					loser.Unsubscribe()
+				}
+				if won && attempt.Subscribed() {
+					observe(next, err, done)
+					if done {
+						attempt.Unsubscribe()
+					}
+				}
+			}
+		}
+		launch := func() bool {
+			hedge.Lock()
+			if hedge.winner != nil {
+				hedge.Unlock()
+				return false
+			}
+			attempt := subscriber.Add()
+			hedge.attempts = append(hedge.attempts, attempt)
+			hedge.Unlock()
+			create()(subscribe(attempt), scheduler, attempt)
+			return true
+		}
+		if maxAttempts > 1 {
+			launched := 1
+			hedger := scheduler.ScheduleFutureRecursive(delay, func(again func(time.Duration)) {
+				if subscriber.Subscribed() && launch() {
+					if launched++; launched < maxAttempts {
+						again(delay)
+					}
+				}
+			})
+			subscriber.OnUnsubscribe(hedger.Cancel)
+		}
+		launch()
+	}
+}
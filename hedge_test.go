@@ -0,0 +1,29 @@
+package rx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestHedge(t *testing.T) {
+	t.Run("Factory calls are serialized on a concurrent scheduler", func(t *testing.T) {
+		const ms = time.Millisecond
+		attempts := 0
+		lookup := func() rx.Observable[int] {
+			// not synchronized, relies on Hedge serializing the calls
+			attempts++
+			time.Sleep(2 * ms)
+			return rx.Of(attempts).Delay(50 * ms)
+		}
+
+		results, err := rx.Hedge(lookup, 1*ms, 5).Slice(rx.Goroutine)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("expected a single result, got %v", results)
+		}
+	})
+}
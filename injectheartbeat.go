@@ -0,0 +1,82 @@
+package rx
+
+import (
+	"sync"
+	"time"
+)
+
+// InjectHeartbeat returns a Pipe that emits value as a keepalive whenever the
+// source Observable did not emit anything for period. While the source stays
+// idle, value is emitted again every period. This keeps downstream consumers,
+// like a Heartbeat or a connection with an idle timeout, from seeing a stream
+// that is alive but has nothing to say as stalled.
+func InjectHeartbeat[T any](period time.Duration, value T) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			var serial sync.Mutex // serializes emissions
+			var inject struct {
+				sync.Mutex
+				done     bool
+				deadline time.Time
+				cancel   func()
+			}
+			injector := func(again func(time.Duration)) {
+				serial.Lock()
+				defer serial.Unlock()
+				inject.Lock()
+				if inject.done || !subscriber.Subscribed() {
+					inject.Unlock()
+					return
+				}
+				if remaining := inject.deadline.Sub(scheduler.Now()); remaining > 0 {
+					inject.Unlock()
+					again(remaining)
+					return
+				}
+				inject.deadline = scheduler.Now().Add(period)
+				inject.Unlock()
+				observe(value, nil, false)
+				again(period)
+			}
+			cancel := func() {
+				if inject.cancel != nil {
+					inject.cancel()
+					inject.cancel = nil
+				}
+			}
+			subscriber.OnUnsubscribe(func() {
+				inject.Lock()
+				cancel()
+				inject.Unlock()
+			})
+			observer := func(next T, err error, done bool) {
+				serial.Lock()
+				defer serial.Unlock()
+				inject.Lock()
+				if inject.done || !subscriber.Subscribed() {
+					inject.Unlock()
+					return
+				}
+				if !done {
+					inject.deadline = scheduler.Now().Add(period)
+				} else {
+					inject.done = true
+					cancel()
+				}
+				inject.Unlock()
+				observe(next, err, done)
+			}
+			inject.Lock()
+			inject.deadline = scheduler.Now().Add(period)
+			inject.cancel = scheduler.ScheduleFutureRecursive(period, injector).Cancel
+			inject.Unlock()
+			observable(observer, scheduler, subscriber)
+		}
+	}
+}
+
+// InjectHeartbeat returns an Observable that emits value as a keepalive
+// whenever the observable did not emit anything for period.
+func (observable Observable[T]) InjectHeartbeat(period time.Duration, value T) Observable[T] {
+	return InjectHeartbeat(period, value)(observable)
+}
@@ -0,0 +1,8 @@
+package rx
+
+// IsEmpty returns an Observable that emits true when the source Observable
+// completes without emitting any values. As soon as the source emits a value,
+// it emits false, completes and unsubscribes from the source.
+func (observable Observable[T]) IsEmpty() Observable[bool] {
+	return observable.Every(func(T) bool { return false })
+}
@@ -0,0 +1,181 @@
+package rx
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrLoaderKeyNotFound is emitted by an Observable returned from Loader.Load
+// when the batch function completed without returning a value for the key.
+var ErrLoaderKeyNotFound = errors.Join(Err, errors.New("loader key not found"))
+
+// Loader collects keyed lookups made through its Load method and resolves them
+// in batches with a single call to a batch function. Resolved values are
+// cached per key, so loading the same key again will not call the batch
+// function. Errors are not cached.
+//
+// A Loader is useful to remove N+1 lookup patterns, where many independent
+// parts of a program each request a single item from the same backend.
+type Loader[K comparable, V any] struct {
+	batchFn  func([]K) Observable[map[K]V]
+	maxBatch int
+	wait     time.Duration
+	state    struct {
+		sync.Mutex
+		cache map[K]*loaderEntry[V]
+		batch *loaderBatch[K, V]
+	}
+}
+
+type loaderEntry[V any] struct {
+	resolved bool
+	value    V
+	err      error
+	waiters  []chan *loaderEntry[V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	entries []*loaderEntry[V]
+	cancel  func()
+}
+
+// NewLoader creates a Loader that calls batchFn with the keys collected during
+// a time window of duration wait, starting at the first Load of a new batch.
+// A batch is dispatched early when it reaches maxBatch keys, a maxBatch of 0
+// or less means batches are only limited by the wait duration.
+//
+// The batchFn may emit one or more maps, the maps are merged and every key
+// of the batch is resolved when the Observable returned by batchFn completes.
+func NewLoader[K comparable, V any](batchFn func([]K) Observable[map[K]V], maxBatch int, wait time.Duration) *Loader[K, V] {
+	loader := &Loader[K, V]{batchFn: batchFn, maxBatch: maxBatch, wait: wait}
+	loader.state.cache = make(map[K]*loaderEntry[V])
+	return loader
+}
+
+// Load returns an Observable that emits the value for key and then completes.
+// The key is added to the current batch when the Observable is subscribed to,
+// unless a value for it is already cached or being loaded.
+//
+// Batches are timed and dispatched on the Goroutine scheduler, independent of
+// the scheduler of any subscription. Every subscription receives its result on
+// its own scheduler, so Load can be used from concurrent goroutines.
+func (l *Loader[K, V]) Load(key K) Observable[V] {
+	return func(observe Observer[V], scheduler Scheduler, subscriber Subscriber) {
+		loaded := func(value V, err error) Observable[V] {
+			if err != nil {
+				return Throw[V](err)
+			}
+			return Of(value)
+		}
+		l.state.Lock()
+		entry, cached := l.state.cache[key]
+		if cached && entry.resolved {
+			l.state.Unlock()
+			loaded(entry.value, entry.err)(observe, scheduler, subscriber)
+			return
+		}
+		var full *loaderBatch[K, V]
+		if !cached {
+			entry = &loaderEntry[V]{}
+			l.state.cache[key] = entry
+			full = l.enqueue(key, entry)
+		}
+		waiter := make(chan *loaderEntry[V], 1)
+		entry.waiters = append(entry.waiters, waiter)
+		l.state.Unlock()
+		if full != nil {
+			l.dispatch(full)
+		}
+		cancel := make(chan struct{})
+		runner := scheduler.Schedule(func() {
+			select {
+			case entry := <-waiter:
+				if subscriber.Subscribed() {
+					loaded(entry.value, entry.err)(observe, scheduler, subscriber)
+				}
+			case <-cancel:
+			}
+		})
+		subscriber.OnUnsubscribe(func() {
+			runner.Cancel()
+			close(cancel)
+		})
+	}
+}
+
+// Clear removes the cached value for key, so the next Load of key will cause
+// it to be loaded again.
+func (l *Loader[K, V]) Clear(key K) {
+	l.state.Lock()
+	delete(l.state.cache, key)
+	l.state.Unlock()
+}
+
+func (l *Loader[K, V]) enqueue(key K, entry *loaderEntry[V]) *loaderBatch[K, V] {
+	batch := l.state.batch
+	if batch == nil {
+		batch = &loaderBatch[K, V]{}
+		l.state.batch = batch
+		runner := Goroutine.ScheduleFuture(l.wait, func() {
+			l.state.Lock()
+			current := (l.state.batch == batch)
+			if current {
+				l.state.batch = nil
+			}
+			l.state.Unlock()
+			if current {
+				l.dispatch(batch)
+			}
+		})
+		batch.cancel = runner.Cancel
+	}
+	batch.keys = append(batch.keys, key)
+	batch.entries = append(batch.entries, entry)
+	if l.maxBatch > 0 && len(batch.keys) >= l.maxBatch {
+		l.state.batch = nil
+		batch.cancel()
+		return batch
+	}
+	return nil
+}
+
+func (l *Loader[K, V]) dispatch(batch *loaderBatch[K, V]) {
+	results := make(map[K]V, len(batch.keys))
+	observer := func(next map[K]V, err error, done bool) {
+		if !done {
+			for key, value := range next {
+				results[key] = value
+			}
+		} else {
+			l.resolve(batch, results, err)
+		}
+	}
+	l.batchFn(batch.keys).Subscribe(observer, Goroutine)
+}
+
+func (l *Loader[K, V]) resolve(batch *loaderBatch[K, V], results map[K]V, err error) {
+	l.state.Lock()
+	defer l.state.Unlock()
+	for i, key := range batch.keys {
+		entry := batch.entries[i]
+		value, found := results[key]
+		switch {
+		case err != nil:
+			entry.err = err
+		case !found:
+			entry.err = ErrLoaderKeyNotFound
+		default:
+			entry.value = value
+		}
+		entry.resolved = true
+		if entry.err != nil && l.state.cache[key] == entry {
+			delete(l.state.cache, key)
+		}
+		for _, waiter := range entry.waiters {
+			waiter <- entry
+		}
+		entry.waiters = nil
+	}
+}
@@ -0,0 +1,76 @@
+package rx_test
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestLoader(t *testing.T) {
+	const callers = 5
+
+	t.Run("Concurrent loads", func(t *testing.T) {
+		var batched atomic.Int32
+		batchFn := func(keys []int) rx.Observable[map[int]string] {
+			batched.Add(int32(len(keys)))
+			values := make(map[int]string)
+			for _, key := range keys {
+				values[key] = "value-" + strconv.Itoa(key)
+			}
+			return rx.Of(values)
+		}
+		loader := rx.NewLoader(batchFn, 0, 20*time.Millisecond)
+
+		var wg sync.WaitGroup
+		values := make([]string, 2*callers)
+		errs := make([]error, 2*callers)
+		for i := range 2 * callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				// every key is loaded by two callers
+				values[i], errs[i] = loader.Load(i % callers).First()
+			}()
+		}
+		wg.Wait()
+
+		for i := range 2 * callers {
+			if errs[i] != nil {
+				t.Fatalf("caller %d: unexpected error %v", i, errs[i])
+			}
+			if expect := "value-" + strconv.Itoa(i%callers); values[i] != expect {
+				t.Fatalf("caller %d: expected %q, got %q", i, expect, values[i])
+			}
+		}
+		if n := batched.Load(); n != callers {
+			t.Fatalf("expected %d keys to be batched, got %d", callers, n)
+		}
+	})
+
+	t.Run("Concurrent waits with full batches", func(t *testing.T) {
+		batchFn := func(keys []int) rx.Observable[map[int]int] {
+			values := make(map[int]int)
+			for _, key := range keys {
+				values[key] = key * key
+			}
+			return rx.Of(values)
+		}
+		loader := rx.NewLoader(batchFn, 2, time.Hour)
+
+		var wg sync.WaitGroup
+		for i := range 2 * callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := loader.Load(i).Wait(); err != nil {
+					t.Errorf("caller %d: unexpected error %v", i, err)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
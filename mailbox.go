@@ -0,0 +1,92 @@
+package rx
+
+import (
+	"sync"
+	"time"
+)
+
+// mailbox is an unbounded queue of emissions for a single subscriber. Any
+// goroutine may send to it without ever blocking, while the subscriber drains
+// it from a task running on its own scheduler. This decouples a shared source
+// from the pace of each of its subscribers.
+type mailbox[T any] struct {
+	sync.Mutex
+	emissions []emission[T]
+	signal    chan struct{}
+}
+
+type emission[T any] struct {
+	next T
+	err  error
+	done bool
+}
+
+func newMailbox[T any]() *mailbox[T] {
+	return &mailbox[T]{signal: make(chan struct{}, 1)}
+}
+
+// send appends an emission to the mailbox and signals the receiving task.
+func (m *mailbox[T]) send(next T, err error, done bool) {
+	m.Lock()
+	m.emissions = append(m.emissions, emission[T]{next, err, done})
+	m.Unlock()
+	select {
+	case m.signal <- struct{}{}:
+	default:
+	}
+}
+
+// deliver schedules a task on the scheduler that passes the emissions sent to
+// the mailbox on to observe, until a termination has been delivered or the
+// subscriber unsubscribes. On a concurrent scheduler the task blocks while the
+// mailbox is empty. On a serial scheduler it polls at an interval that grows
+// from 50us up to 5ms, so other tasks on the same scheduler keep running.
+func (m *mailbox[T]) deliver(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+	const (
+		us = time.Microsecond
+		ms = time.Millisecond
+	)
+	cancel := make(chan struct{})
+	poll := 50 * us
+	runner := scheduler.ScheduleFutureRecursive(0, func(again func(time.Duration)) {
+		if !subscriber.Subscribed() {
+			return
+		}
+		m.Lock()
+		emissions := m.emissions
+		m.emissions = nil
+		m.Unlock()
+		for _, e := range emissions {
+			if !subscriber.Subscribed() {
+				return
+			}
+			observe(e.next, e.err, e.done)
+			if e.done {
+				return
+			}
+		}
+		if len(emissions) > 0 {
+			poll = 50 * us
+		}
+		if scheduler.IsConcurrent() {
+			select {
+			case <-m.signal:
+				again(0)
+			case <-cancel:
+			}
+			return
+		}
+		select {
+		case <-m.signal:
+			poll = 50 * us
+			again(0)
+		default:
+			again(poll)
+			poll = min(2*poll, 5*ms)
+		}
+	})
+	subscriber.OnUnsubscribe(func() {
+		runner.Cancel()
+		close(cancel)
+	})
+}
@@ -0,0 +1,8 @@
+package rx
+
+// Max returns an Observable that emits the largest value emitted by the
+// source Observable when it completes. For an empty source, the Observable
+// completes without emitting.
+func Max[T Integer | Float](observable Observable[T]) Observable[T] {
+	return MaxBy(observable, func(next T) T { return next })
+}
@@ -0,0 +1,9 @@
+package rx
+
+// MaxBy returns an Observable that emits the value emitted by the source
+// Observable for which the key function returns the largest key. When several
+// values share the largest key, the first of them is emitted. For an empty
+// source, the Observable completes without emitting.
+func MaxBy[T any, K Integer | Float](observable Observable[T], key func(T) K) Observable[T] {
+	return extremeBy(observable, key, func(a, b K) bool { return a > b })
+}
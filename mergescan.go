@@ -0,0 +1,85 @@
+package rx
+
+import "sync"
+
+// MergeScan applies an asynchronous accumulator to every value emitted by the
+// source Observable. The accumulator is called with the latest accumulated
+// value (initially seed) and the next source value, and returns an Observable
+// whose values are emitted and become the new accumulated value. It completes
+// when the source and all accumulator Observables have completed.
+//
+// At most concurrency accumulator Observables are subscribed to at the same
+// time, a concurrency of 0 or less means no limit. Source values that arrive
+// while the limit is reached are buffered and accumulated in order. Use a
+// concurrency of 1 to fold state strictly sequentially.
+func MergeScan[T, U any](observable Observable[T], seed U, accumulator func(acc U, next T) Observable[U], concurrency int) Observable[U] {
+	return func(observe Observer[U], scheduler Scheduler, subscriber Subscriber) {
+		var scan struct {
+			sync.Mutex
+			acc        U
+			done       bool
+			sourceDone bool
+			inner      int
+			pending    []T
+		}
+		scan.acc = seed
+		var scanner Observer[U]
+		launch := func() {
+			for {
+				scan.Lock()
+				if scan.done || len(scan.pending) == 0 || (concurrency > 0 && scan.inner >= concurrency) {
+					if !scan.done && scan.sourceDone && scan.inner == 0 && len(scan.pending) == 0 {
+						scan.done = true
+						var zero U
+						observe(zero, nil, true)
+					}
+					scan.Unlock()
+					return
+				}
+				next := scan.pending[0]
+				scan.pending = scan.pending[1:]
+				acc := scan.acc
+				scan.inner++
+				scan.Unlock()
+				accumulator(acc, next).AutoUnsubscribe()(scanner, scheduler, subscriber)
+			}
+		}
+		scanner = func(next U, err error, done bool) {
+			scan.Lock()
+			if !scan.done {
+				switch {
+				case !done:
+					scan.acc = next
+					observe(next, nil, false)
+				case err != nil:
+					scan.done = true
+					observe(next, err, true)
+				default:
+					scan.inner--
+				}
+			}
+			scan.Unlock()
+			if done {
+				launch()
+			}
+		}
+		observer := func(next T, err error, done bool) {
+			scan.Lock()
+			if !scan.done {
+				switch {
+				case !done:
+					scan.pending = append(scan.pending, next)
+				case err != nil:
+					scan.done = true
+					var zero U
+					observe(zero, err, true)
+				default:
+					scan.sourceDone = true
+				}
+			}
+			scan.Unlock()
+			launch()
+		}
+		observable.AutoUnsubscribe()(observer, scheduler, subscriber)
+	}
+}
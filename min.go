@@ -0,0 +1,8 @@
+package rx
+
+// Min returns an Observable that emits the smallest value emitted by the
+// source Observable when it completes. For an empty source, the Observable
+// completes without emitting.
+func Min[T Integer | Float](observable Observable[T]) Observable[T] {
+	return MinBy(observable, func(next T) T { return next })
+}
@@ -0,0 +1,34 @@
+package rx
+
+// MinBy returns an Observable that emits the value emitted by the source
+// Observable for which the key function returns the smallest key. When several
+// values share the smallest key, the first of them is emitted. For an empty
+// source, the Observable completes without emitting.
+func MinBy[T any, K Integer | Float](observable Observable[T], key func(T) K) Observable[T] {
+	return extremeBy(observable, key, func(a, b K) bool { return a < b })
+}
+
+func extremeBy[T any, K Integer | Float](observable Observable[T], key func(T) K, better func(K, K) bool) Observable[T] {
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		var extreme struct {
+			initialized bool
+			key         K
+			value       T
+		}
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				if k := key(next); !extreme.initialized || better(k, extreme.key) {
+					extreme.initialized = true
+					extreme.key = k
+					extreme.value = next
+				}
+			case err != nil || !extreme.initialized:
+				var zero T
+				observe(zero, err, true)
+			default:
+				Of(extreme.value)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
@@ -0,0 +1,49 @@
+package rx
+
+// Page is a single page of items returned by a cursor-based API. When HasNext
+// is true, Next is the cursor to fetch the following page with.
+type Page[C, T any] struct {
+	Items   []T
+	Next    C
+	HasNext bool
+}
+
+// Paginate creates an Observable that emits the items of all pages of a
+// cursor-based API. It fetches the page for the first cursor and emits its
+// items. When the Observable returned by fetch completes and the page has a
+// next cursor, the next page is fetched. A page is only fetched when the
+// subscription is still active, so e.g. Take stops the pagination cleanly.
+//
+// An error emitted by fetch is emitted and ends the pagination. To retry a
+// failing page, apply an operator like RetryTime to the Observable returned
+// by fetch.
+func Paginate[C, T any](first C, fetch func(cursor C) Observable[Page[C, T]]) Observable[T] {
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		var fetchPage func(cursor C)
+		fetchPage = func(cursor C) {
+			var last Page[C, T]
+			observer := func(next Page[C, T], err error, done bool) {
+				switch {
+				case !done:
+					last = next
+					for _, item := range next.Items {
+						if !subscriber.Subscribed() {
+							return
+						}
+						observe(item, nil, false)
+					}
+				case err != nil:
+					var zero T
+					observe(zero, err, true)
+				case last.HasNext && subscriber.Subscribed():
+					fetchPage(last.Next)
+				default:
+					var zero T
+					observe(zero, nil, true)
+				}
+			}
+			fetch(cursor).AutoUnsubscribe()(observer, scheduler, subscriber)
+		}
+		fetchPage(first)
+	}
+}
@@ -0,0 +1,25 @@
+package rx
+
+// Pairwise returns an Observable that emits the previous and the current value
+// emitted by the source Observable as a Tuple2 (First is previous, Second is
+// current). Nothing is emitted for the first value, as it has no predecessor.
+func Pairwise[T any](observable Observable[T]) Observable[Tuple2[T, T]] {
+	return func(observe Observer[Tuple2[T, T]], scheduler Scheduler, subscriber Subscriber) {
+		var previous struct {
+			initialized bool
+			value       T
+		}
+		observable(func(next T, err error, done bool) {
+			if !done {
+				if previous.initialized {
+					observe(Tuple2[T, T]{previous.value, next}, nil, false)
+				}
+				previous.initialized = true
+				previous.value = next
+			} else {
+				var zero Tuple2[T, T]
+				observe(zero, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
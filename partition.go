@@ -0,0 +1,20 @@
+package rx
+
+// Partition splits the source Observable into two Observables, the first emits
+// the values for which predicate returns true and the second emits the values
+// for which it returns false. Both Observables complete or error along with
+// the source.
+//
+// The source is subscribed to only once, when both Observables have been
+// subscribed to, so each of them must be subscribed to exactly once. A later
+// subscription receives an ErrLateSubscription error. To use only one side,
+// filter the source with Filter instead. Every subscriber receives its values
+// through a queue of its own, so a slow subscriber does not block the other.
+func Partition[T any](source Observable[T], predicate func(T) bool) (Observable[T], Observable[T]) {
+	tag := func(next T) Tuple2[T, bool] { return Tuple2[T, bool]{next, predicate(next)} }
+	tagged := fanOut(Map(source, tag), 2)
+	matched := func(next Tuple2[T, bool]) bool { return next.Second }
+	rejected := func(next Tuple2[T, bool]) bool { return !next.Second }
+	value := func(next Tuple2[T, bool]) T { return next.First }
+	return Map(tagged.Filter(matched), value), Map(tagged.Filter(rejected), value)
+}
@@ -0,0 +1,83 @@
+package rx_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestPartition(t *testing.T) {
+	isEven := func(next int) bool { return next%2 == 0 }
+
+	t.Run("Both sides on a serial scheduler", func(t *testing.T) {
+		subscriptions := 0
+		source := rx.Defer(func() rx.Observable[int] {
+			subscriptions++
+			return rx.From(1, 2, 3, 4, 5, 6)
+		})
+		even, odd := rx.Partition(source, isEven)
+		var evens, odds []int
+		serial := rx.NewScheduler()
+		first := even.Append(&evens).Go(serial)
+		second := odd.Append(&odds).Go(serial)
+		serial.Wait()
+		if first.Err() != nil || second.Err() != nil {
+			t.Fatal(first.Err(), second.Err())
+		}
+		if !slices.Equal(evens, []int{2, 4, 6}) || !slices.Equal(odds, []int{1, 3, 5}) {
+			t.Fatalf("expected [2 4 6] and [1 3 5], got %v and %v", evens, odds)
+		}
+		if subscriptions != 1 {
+			t.Fatalf("expected 1 subscription to the source, got %d", subscriptions)
+		}
+	})
+
+	t.Run("Both sides on goroutines", func(t *testing.T) {
+		release := make(chan int)
+		source := rx.Concat(rx.Recv(release), rx.From(1, 2, 3, 4, 5, 6))
+		even, odd := rx.Partition(source, isEven)
+		var evens, odds []int
+		first := even.Append(&evens).Go()
+		second := odd.Append(&odds).Go()
+		close(release)
+		if err := first.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if err := second.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(evens, []int{2, 4, 6}) || !slices.Equal(odds, []int{1, 3, 5}) {
+			t.Fatalf("expected [2 4 6] and [1 3 5], got %v and %v", evens, odds)
+		}
+	})
+	t.Run("Second side subscribed later", func(t *testing.T) {
+		even, odd := rx.Partition(rx.From(1, 2, 3, 4, 5, 6), isEven)
+		var evens, odds []int
+		first := even.Append(&evens).Go()
+		time.Sleep(50 * time.Millisecond)
+		second := odd.Append(&odds).Go()
+		if err := first.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if err := second.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(evens, []int{2, 4, 6}) || !slices.Equal(odds, []int{1, 3, 5}) {
+			t.Fatalf("expected [2 4 6] and [1 3 5], got %v and %v", evens, odds)
+		}
+	})
+
+	t.Run("Late subscription", func(t *testing.T) {
+		even, odd := rx.Partition(rx.From(1, 2, 3, 4, 5, 6), isEven)
+		serial := rx.NewScheduler()
+		even.Go(serial)
+		odd.Go(serial)
+		serial.Wait()
+		if err := even.Wait(); !errors.Is(err, rx.ErrLateSubscription) {
+			t.Fatalf("expected ErrLateSubscription, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package rx
+
+import "time"
+
+// PollOption is a function type used for configuring how Poll handles errors
+// returned by its fetch function.
+type PollOption func(*pollOptions)
+
+type pollOptions struct {
+	backoff func(int) time.Duration
+}
+
+// WithBackoff creates a PollOption that makes Poll retry a failed fetch after
+// the duration returned by backoff, instead of emitting the error. The backoff
+// function is called with the number of consecutive failures so far, so it is
+// 1 after the first failure.
+func WithBackoff(backoff func(failures int) time.Duration) PollOption {
+	return func(options *pollOptions) {
+		options.backoff = backoff
+	}
+}
+
+// Poll creates an Observable that calls fetch on the scheduler immediately and
+// then every interval, emitting the fetched value only when it differs from
+// the previously emitted value according to equal (see DistinctUntilChanged).
+// The interval is measured from the end of a fetch to the start of the next,
+// so calls to fetch never overlap.
+//
+// When fetch returns an error, the Observable emits that error, unless the
+// WithBackoff option is passed. Then the next fetch is scheduled after the
+// backoff duration and a successful fetch returns to the regular interval.
+func Poll[T any](interval time.Duration, fetch func() (T, error), equal func(T, T) bool, options ...PollOption) Observable[T] {
+	var opts pollOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	poll := Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		failures := 0
+		poller := scheduler.ScheduleFutureRecursive(0, func(again func(time.Duration)) {
+			if subscriber.Subscribed() {
+				next, err := fetch()
+				if !subscriber.Subscribed() {
+					return
+				}
+				switch {
+				case err == nil:
+					failures = 0
+					observe(next, nil, false)
+					if subscriber.Subscribed() {
+						again(interval)
+					}
+				case opts.backoff != nil:
+					failures++
+					again(opts.backoff(failures))
+				default:
+					var zero T
+					observe(zero, err, true)
+				}
+			}
+		})
+		subscriber.OnUnsubscribe(poller.Cancel)
+	})
+	return poll.DistinctUntilChanged(equal)
+}
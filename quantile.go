@@ -0,0 +1,105 @@
+package rx
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrInvalidQuantile is emitted by Quantile when p is not in the range (0, 1).
+var ErrInvalidQuantile = errors.Join(Err, errors.New("invalid quantile"))
+
+// Quantile returns an Observable that emits an estimate of the p-quantile of
+// the values emitted by the source Observable so far, for every value the
+// source emits. For example, p = 0.5 estimates the median and p = 0.99 the
+// 99th percentile. Combine it with SampleTime to emit estimates on a fixed
+// cadence instead.
+//
+// The estimate is calculated with the P² algorithm of Jain and Chlamtac, which
+// uses constant memory and does not store the values. The estimate is exact
+// for the first 5 values.
+//
+// If p is not in the range (0, 1), it returns an Observable that emits an
+// ErrInvalidQuantile error.
+func Quantile[T Integer | Float](observable Observable[T], p float64) Observable[float64] {
+	if p <= 0 || p >= 1 {
+		return Throw[float64](ErrInvalidQuantile)
+	}
+	return func(observe Observer[float64], scheduler Scheduler, subscriber Subscriber) {
+		var (
+			count   int
+			heights [5]float64 // marker heights
+			actual  [5]float64 // actual marker positions
+			desired [5]float64 // desired marker positions
+		)
+		increments := [5]float64{0, p / 2, p, (1 + p) / 2, 1}
+		observable(func(next T, err error, done bool) {
+			if done {
+				observe(0, err, true)
+				return
+			}
+			x := float64(next)
+			if count < 5 {
+				heights[count] = x
+				count++
+				sample := heights[:count]
+				slices.Sort(sample)
+				if count == 5 {
+					actual = [5]float64{1, 2, 3, 4, 5}
+					desired = [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5}
+				}
+				rank := p * float64(count-1)
+				lower := int(rank)
+				if lower+1 < count {
+					observe(sample[lower]+(rank-float64(lower))*(sample[lower+1]-sample[lower]), nil, false)
+				} else {
+					observe(sample[lower], nil, false)
+				}
+				return
+			}
+			count++
+			var k int
+			switch {
+			case x < heights[0]:
+				heights[0] = x
+				k = 0
+			case x < heights[1]:
+				k = 0
+			case x < heights[2]:
+				k = 1
+			case x < heights[3]:
+				k = 2
+			case x <= heights[4]:
+				k = 3
+			default:
+				heights[4] = x
+				k = 3
+			}
+			for i := k + 1; i < 5; i++ {
+				actual[i]++
+			}
+			for i := range desired {
+				desired[i] += increments[i]
+			}
+			for i := 1; i < 4; i++ {
+				d := desired[i] - actual[i]
+				if (d >= 1 && actual[i+1]-actual[i] > 1) || (d <= -1 && actual[i-1]-actual[i] < -1) {
+					s := 1.0
+					if d < 0 {
+						s = -1.0
+					}
+					parabolic := heights[i] + s/(actual[i+1]-actual[i-1])*
+						((actual[i]-actual[i-1]+s)*(heights[i+1]-heights[i])/(actual[i+1]-actual[i])+
+							(actual[i+1]-actual[i]-s)*(heights[i]-heights[i-1])/(actual[i]-actual[i-1]))
+					if heights[i-1] < parabolic && parabolic < heights[i+1] {
+						heights[i] = parabolic
+					} else {
+						j := i + int(s)
+						heights[i] += s * (heights[j] - heights[i]) / (actual[j] - actual[i])
+					}
+					actual[i] += s
+				}
+			}
+			observe(heights[2], nil, false)
+		}, scheduler, subscriber)
+	}
+}
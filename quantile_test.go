@@ -0,0 +1,43 @@
+package rx_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/reactivego/rx"
+)
+
+func TestQuantile(t *testing.T) {
+	t.Run("Exact for first values", func(t *testing.T) {
+		estimate, err := rx.Quantile(rx.From(5, 1, 3), 0.5).Last()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if estimate != 3 {
+			t.Errorf("Expected 3, got %v", estimate)
+		}
+	})
+
+	t.Run("Estimates percentiles of a large stream", func(t *testing.T) {
+		const n = 10000
+		values := rand.New(rand.NewSource(1)).Perm(n)
+		for _, p := range []float64{0.5, 0.9, 0.99} {
+			estimate, err := rx.Quantile(rx.From(values...), p).Last()
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			expected := p * n
+			if math.Abs(estimate-expected) > 0.01*n {
+				t.Errorf("Expected p%v near %v, got %v", p*100, expected, estimate)
+			}
+		}
+	})
+
+	t.Run("Invalid quantile", func(t *testing.T) {
+		_, err := rx.Quantile(rx.From(1, 2, 3), 1).Last()
+		if err != rx.ErrInvalidQuantile {
+			t.Errorf("Expected ErrInvalidQuantile, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package rx
+
+import "errors"
+
+// ErrRangeOverflow is emitted by Range when not all of the integers in the
+// range can be represented by its integer type.
+var ErrRangeOverflow = errors.Join(Err, errors.New("range overflow"))
+
+// Range creates an Observable that emits count sequential integers, starting
+// at start, and then completes. When count is 0 or less, it completes without
+// emitting. When the last integer start+count-1 overflows the integer type T,
+// it emits an ErrRangeOverflow error without emitting any integers.
+func Range[T Integer](start T, count int) Observable[T] {
+	if count <= 0 {
+		return Empty[T]()
+	}
+	// the wrapped around difference between last and start only equals count-1
+	// when computing last did not overflow, whatever the sign of start.
+	if last := start + T(count-1); last < start || uint64(last)-uint64(start) != uint64(count-1) {
+		return Throw[T](ErrRangeOverflow)
+	}
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		task := func(index int, again func(next int)) {
+			if subscriber.Subscribed() {
+				if index < count {
+					observe(start+T(index), nil, false)
+					if subscriber.Subscribed() {
+						again(index + 1)
+					}
+				} else {
+					var zero T
+					observe(zero, nil, true)
+				}
+			}
+		}
+		runner := scheduler.ScheduleLoop(0, task)
+		subscriber.OnUnsubscribe(runner.Cancel)
+	}
+}
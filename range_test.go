@@ -0,0 +1,53 @@
+package rx_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/reactivego/rx"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("Negative start", func(t *testing.T) {
+		values, err := rx.Range[int8](-100, 200).Slice()
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if len(values) != 200 || values[0] != -100 || values[199] != 99 {
+			t.Fatalf("expected -100 up to 99, got %d values from %d to %d", len(values), values[0], values[len(values)-1])
+		}
+	})
+
+	t.Run("Last value at the maximum", func(t *testing.T) {
+		values, err := rx.Range[int8](-128, 256).Slice()
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if len(values) != 256 || values[255] != math.MaxInt8 {
+			t.Fatalf("expected 256 values up to %d, got %d", math.MaxInt8, len(values))
+		}
+		if _, err := rx.Range[uint64](math.MaxUint64-1, 2).Slice(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		cases := []rx.Observable[int8]{
+			rx.Range[int8](-100, 229),
+			rx.Range[int8](0, 129),
+			rx.Range[int8](-128, 257),
+		}
+		for i, observable := range cases {
+			if _, err := observable.Slice(); !errors.Is(err, rx.ErrRangeOverflow) {
+				t.Errorf("case %d: expected ErrRangeOverflow, got %v", i, err)
+			}
+		}
+		if _, err := rx.Range[uint8](0, 257).Slice(); !errors.Is(err, rx.ErrRangeOverflow) {
+			t.Errorf("expected ErrRangeOverflow, got %v", err)
+		}
+		if _, err := rx.Range[int64](math.MaxInt64-1, 3).Slice(); !errors.Is(err, rx.ErrRangeOverflow) {
+			t.Errorf("expected ErrRangeOverflow, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package rx
+
+// RunningMinMax returns an Observable that emits, for every value emitted by
+// the source Observable, a Tuple2 with the smallest (First) and largest
+// (Second) value seen so far.
+func RunningMinMax[T Integer | Float](observable Observable[T]) Observable[Tuple2[T, T]] {
+	return func(observe Observer[Tuple2[T, T]], scheduler Scheduler, subscriber Subscriber) {
+		var minmax Tuple2[T, T]
+		initialized := false
+		observable(func(next T, err error, done bool) {
+			if !done {
+				if !initialized || next < minmax.First {
+					minmax.First = next
+				}
+				if !initialized || next > minmax.Second {
+					minmax.Second = next
+				}
+				initialized = true
+				observe(minmax, nil, false)
+			} else {
+				var zero Tuple2[T, T]
+				observe(zero, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
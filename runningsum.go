@@ -0,0 +1,8 @@
+package rx
+
+// RunningSum returns an Observable that emits the sum of all values emitted by
+// the source Observable so far, for every value the source emits. For integer
+// types, an ErrSumOverflow error is emitted when the sum overflows.
+func RunningSum[T Integer | Float](observable Observable[T]) Observable[T] {
+	return ScanE(observable, 0, add[T]).AutoUnsubscribe()
+}
@@ -0,0 +1,42 @@
+package rx
+
+import "math"
+
+// Moments holds the count, mean and sample variance of the values seen so far.
+type Moments struct {
+	Count    int
+	Mean     float64
+	Variance float64
+}
+
+// StdDev returns the sample standard deviation, the square root of Variance.
+func (m Moments) StdDev() float64 {
+	return math.Sqrt(m.Variance)
+}
+
+// RunningVariance returns an Observable that emits the Moments of the values
+// emitted by the source Observable so far, for every value the source emits.
+// It uses Welford's algorithm, which is numerically stable and needs constant
+// memory. The Variance is the sample variance and is 0 while fewer than 2
+// values have been seen.
+func RunningVariance[T Integer | Float](observable Observable[T]) Observable[Moments] {
+	return func(observe Observer[Moments], scheduler Scheduler, subscriber Subscriber) {
+		var moments Moments
+		var m2 float64
+		observable(func(next T, err error, done bool) {
+			if !done {
+				x := float64(next)
+				moments.Count++
+				delta := x - moments.Mean
+				moments.Mean += delta / float64(moments.Count)
+				m2 += delta * (x - moments.Mean)
+				if moments.Count > 1 {
+					moments.Variance = m2 / float64(moments.Count-1)
+				}
+				observe(moments, nil, false)
+			} else {
+				observe(Moments{}, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
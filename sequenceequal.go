@@ -0,0 +1,70 @@
+package rx
+
+import "sync"
+
+// SequenceEqual returns an Observable that emits true when first and second
+// emit equal sequences of values, compared pairwise by the equal function,
+// and both complete. The equal function is always called with the value from
+// first as its first argument and the value from second as its second. As soon as a pair of values differs or one of the
+// Observables completes before the other, it emits false, completes and
+// unsubscribes from both.
+func SequenceEqual[T any](first, second Observable[T], equal func(T, T) bool) Observable[bool] {
+	return Observable[bool](func(observe Observer[bool], scheduler Scheduler, subscriber Subscriber) {
+		var sequences struct {
+			sync.Mutex
+			queues    [2][]T
+			completed [2]bool
+			done      bool
+		}
+		answer := func(equal bool) {
+			sequences.done = true
+			observe(equal, nil, false)
+			observe(false, nil, true)
+		}
+		makeObserver := func(index int) Observer[T] {
+			other := 1 - index
+			return func(next T, err error, done bool) {
+				sequences.Lock()
+				defer sequences.Unlock()
+				if !sequences.done {
+					switch {
+					case !done:
+						if len(sequences.queues[other]) > 0 {
+							var pair [2]T
+							pair[index], pair[other] = next, sequences.queues[other][0]
+							sequences.queues[other] = sequences.queues[other][1:]
+							if !equal(pair[0], pair[1]) {
+								answer(false)
+							}
+						} else if sequences.completed[other] {
+							answer(false)
+						} else {
+							sequences.queues[index] = append(sequences.queues[index], next)
+						}
+					case err != nil:
+						sequences.done = true
+						observe(false, err, true)
+					default:
+						sequences.completed[index] = true
+						switch {
+						case sequences.completed[other]:
+							answer(len(sequences.queues[index]) == 0 && len(sequences.queues[other]) == 0)
+						case len(sequences.queues[other]) > 0:
+							answer(false)
+						}
+					}
+				}
+			}
+		}
+		first(makeObserver(0), scheduler, subscriber)
+		if subscriber.Subscribed() {
+			second(makeObserver(1), scheduler, subscriber)
+		}
+	}).AutoUnsubscribe()
+}
+
+// SequenceEqual emits true when the Observable and other emit equal sequences
+// of values, compared pairwise by the equal function.
+func (observable Observable[T]) SequenceEqual(other Observable[T], equal func(T, T) bool) Observable[bool] {
+	return SequenceEqual(observable, other, equal)
+}
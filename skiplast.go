@@ -0,0 +1,31 @@
+package rx
+
+// SkipLast returns an Observable that skips the last n values emitted by the
+// source Observable. Values are delayed by n positions, a value is emitted once
+// n newer values have been received from the source.
+func SkipLast[T any](n int) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		if n <= 0 {
+			return observable
+		}
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			buffer := make([]T, 0, n)
+			observable(func(next T, err error, done bool) {
+				if !done {
+					if len(buffer) == n {
+						observe(buffer[0], nil, false)
+						buffer = buffer[1:]
+					}
+					buffer = append(buffer, next)
+				} else {
+					observe(next, err, true)
+				}
+			}, scheduler, subscriber)
+		}
+	}
+}
+
+// SkipLast skips the last n values emitted by the Observable.
+func (observable Observable[T]) SkipLast(n int) Observable[T] {
+	return SkipLast[T](n)(observable)
+}
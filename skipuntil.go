@@ -0,0 +1,50 @@
+package rx
+
+import "sync"
+
+// SkipUntil returns an Observable that skips the values emitted by the source
+// Observable until the notifier Observable emits its first value. From then on
+// the values of the source are mirrored. When the notifier emits an error,
+// that error is emitted. When the notifier completes without emitting a value,
+// all values of the source are skipped.
+func SkipUntil[T, U any](notifier Observable[U]) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			var skip struct {
+				sync.Mutex
+				open bool
+				done bool
+			}
+			observer := func(next T, err error, done bool) {
+				skip.Lock()
+				defer skip.Unlock()
+				if !skip.done && (done || skip.open) {
+					skip.done = done
+					observe(next, err, done)
+				}
+			}
+			notified := subscriber.Add()
+			notifier(func(next U, err error, done bool) {
+				switch {
+				case !done:
+					skip.Lock()
+					skip.open = true
+					skip.Unlock()
+				case err != nil:
+					var zero T
+					observer(zero, err, true)
+				}
+				notified.Unsubscribe()
+			}, scheduler, notified)
+			if subscriber.Subscribed() {
+				observable(observer, scheduler, subscriber)
+			}
+		}).AutoUnsubscribe()
+	}
+}
+
+// SkipUntil skips the values emitted by the Observable until the notifier
+// emits its first value.
+func (observable Observable[T]) SkipUntil(notifier Observable[any]) Observable[T] {
+	return SkipUntil[T](notifier)(observable)
+}
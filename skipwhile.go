@@ -0,0 +1,26 @@
+package rx
+
+// SkipWhile returns an Observable that skips the values emitted by the source
+// Observable as long as the condition returns true. From the first value for
+// which the condition returns false, all values are mirrored.
+func SkipWhile[T any](condition func(T) bool) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			skipping := true
+			observable(func(next T, err error, done bool) {
+				if skipping && !done {
+					if skipping = condition(next); skipping {
+						return
+					}
+				}
+				observe(next, err, done)
+			}, scheduler, subscriber)
+		}
+	}
+}
+
+// SkipWhile skips the values emitted by the Observable as long as the
+// condition returns true.
+func (observable Observable[T]) SkipWhile(condition func(T) bool) Observable[T] {
+	return SkipWhile[T](condition)(observable)
+}
@@ -0,0 +1,78 @@
+package rx
+
+import "time"
+
+// Aggregate holds the count, sum, smallest and largest value of the values
+// currently in a sliding window. Min and Max are zero when Count is zero.
+type Aggregate[T Integer | Float] struct {
+	Count int
+	Sum   T
+	Min   T
+	Max   T
+}
+
+// Mean returns the arithmetic mean of the values in the window, or 0 when the
+// window is empty.
+func (a Aggregate[T]) Mean() float64 {
+	if a.Count == 0 {
+		return 0
+	}
+	return float64(a.Sum) / float64(a.Count)
+}
+
+type windowEntry[T any] struct {
+	value T
+	at    time.Time
+	seq   int
+}
+
+// slidingWindow maintains the aggregate of a FIFO window incrementally. The
+// sum is updated on push and pop, min and max are tracked with monotonic
+// queues so every value is pushed and popped at most once.
+type slidingWindow[T Integer | Float] struct {
+	entries []windowEntry[T]
+	mins    []windowEntry[T]
+	maxs    []windowEntry[T]
+	sum     T
+	seq     int
+}
+
+func (w *slidingWindow[T]) push(value T, at time.Time) {
+	entry := windowEntry[T]{value, at, w.seq}
+	w.seq++
+	w.entries = append(w.entries, entry)
+	w.sum += value
+	for len(w.mins) > 0 && w.mins[len(w.mins)-1].value >= value {
+		w.mins = w.mins[:len(w.mins)-1]
+	}
+	w.mins = append(w.mins, entry)
+	for len(w.maxs) > 0 && w.maxs[len(w.maxs)-1].value <= value {
+		w.maxs = w.maxs[:len(w.maxs)-1]
+	}
+	w.maxs = append(w.maxs, entry)
+}
+
+func (w *slidingWindow[T]) pop() {
+	entry := w.entries[0]
+	w.entries = w.entries[1:]
+	w.sum -= entry.value
+	if w.mins[0].seq == entry.seq {
+		w.mins = w.mins[1:]
+	}
+	if w.maxs[0].seq == entry.seq {
+		w.maxs = w.maxs[1:]
+	}
+}
+
+func (w *slidingWindow[T]) evictBefore(begin time.Time) {
+	for len(w.entries) > 0 && w.entries[0].at.Before(begin) {
+		w.pop()
+	}
+}
+
+func (w *slidingWindow[T]) aggregate() Aggregate[T] {
+	if len(w.entries) == 0 {
+		return Aggregate[T]{}
+	}
+	return Aggregate[T]{Count: len(w.entries), Sum: w.sum, Min: w.mins[0].value, Max: w.maxs[0].value}
+}
@@ -0,0 +1,30 @@
+package rx
+
+import "time"
+
+// SlidingWindowCount returns an Observable that emits the Aggregate of the
+// last size values emitted by the source Observable, every slide values. The
+// aggregate is updated incrementally for every value instead of copying the
+// window, as BufferCount does.
+func SlidingWindowCount[T Integer | Float](observable Observable[T], size, slide int) Observable[Aggregate[T]] {
+	if size < 1 || slide < 1 {
+		return Throw[Aggregate[T]](ErrInvalidCount)
+	}
+	return func(observe Observer[Aggregate[T]], scheduler Scheduler, subscriber Subscriber) {
+		var window slidingWindow[T]
+		received := 0
+		observable(func(next T, err error, done bool) {
+			if !done {
+				window.push(next, time.Time{})
+				if len(window.entries) > size {
+					window.pop()
+				}
+				if received++; received%slide == 0 {
+					observe(window.aggregate(), nil, false)
+				}
+			} else {
+				observe(Aggregate[T]{}, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
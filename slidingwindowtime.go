@@ -0,0 +1,62 @@
+package rx
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrInvalidDuration is emitted by SlidingWindowTime when its span or slide
+// is not a positive duration.
+var ErrInvalidDuration = errors.Join(Err, errors.New("invalid duration"))
+
+// SlidingWindowTime returns an Observable that emits the Aggregate of the
+// values emitted by the source Observable during the last span of time, every
+// slide period. Time is taken from the scheduler. The aggregate is updated
+// incrementally, so keeping e.g. a 1 minute rolling rate over a high frequency
+// stream does not copy the window for every emission. An empty window is
+// emitted as an Aggregate with Count 0.
+//
+// If span or slide is not positive, it returns an Observable that emits an
+// ErrInvalidDuration error.
+func SlidingWindowTime[T Integer | Float](observable Observable[T], span, slide time.Duration) Observable[Aggregate[T]] {
+	if span <= 0 || slide <= 0 {
+		return Throw[Aggregate[T]](ErrInvalidDuration)
+	}
+	return func(observe Observer[Aggregate[T]], scheduler Scheduler, subscriber Subscriber) {
+		var window struct {
+			sync.Mutex
+			slidingWindow[T]
+			done bool
+		}
+		slider := scheduler.ScheduleFutureRecursive(slide, func(again func(time.Duration)) {
+			if subscriber.Subscribed() {
+				window.Lock()
+				if !window.done {
+					window.evictBefore(scheduler.Now().Add(-span))
+					observe(window.aggregate(), nil, false)
+					if subscriber.Subscribed() {
+						again(slide)
+					}
+				}
+				window.Unlock()
+			}
+		})
+		subscriber.OnUnsubscribe(slider.Cancel)
+		observer := func(next T, err error, done bool) {
+			window.Lock()
+			defer window.Unlock()
+			if !window.done {
+				if !done {
+					now := scheduler.Now()
+					window.evictBefore(now.Add(-span))
+					window.push(next, now)
+				} else {
+					window.done = true
+					observe(Aggregate[T]{}, err, true)
+				}
+			}
+		}
+		observable(observer, scheduler, subscriber)
+	}
+}
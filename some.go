@@ -0,0 +1,27 @@
+package rx
+
+// Some returns an Observable that emits true as soon as the predicate returns
+// true for a value emitted by the source Observable, then completes and
+// unsubscribes from the source. When the source completes without such a
+// value, it emits false.
+func (observable Observable[T]) Some(predicate func(T) bool) Observable[bool] {
+	return Observable[bool](func(observe Observer[bool], scheduler Scheduler, subscriber Subscriber) {
+		answered := false
+		observable(func(next T, err error, done bool) {
+			if !answered {
+				switch {
+				case !done:
+					if predicate(next) {
+						answered = true
+						observe(true, nil, false)
+						observe(false, nil, true)
+					}
+				case err != nil:
+					observe(false, err, true)
+				default:
+					Of(false)(observe, scheduler, subscriber)
+				}
+			}
+		}, scheduler, subscriber)
+	}).AutoUnsubscribe()
+}
@@ -0,0 +1,29 @@
+package rx
+
+import "sort"
+
+// Sorted returns an Observable that collects the values emitted by the source
+// Observable and emits them as a slice sorted by less when the source
+// completes. The sort is stable, so equal values keep their emission order.
+func Sorted[T any](observable Observable[T], less func(a, b T) bool) Observable[[]T] {
+	return func(observe Observer[[]T], scheduler Scheduler, subscriber Subscriber) {
+		var items []T
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				items = append(items, next)
+			case err != nil:
+				observe(nil, err, true)
+			default:
+				sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+				Of(items)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
+
+// CollectSorted subscribes to the Observable and waits for it to complete,
+// returning the values sorted by less as Sorted does.
+func CollectSorted[T any](observable Observable[T], less func(a, b T) bool, schedulers ...Scheduler) ([]T, error) {
+	return Sorted(observable, less).First(schedulers...)
+}
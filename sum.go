@@ -0,0 +1,23 @@
+package rx
+
+import "errors"
+
+// ErrSumOverflow is emitted by Sum and RunningSum when adding the next value
+// would overflow the integer type of the sum.
+var ErrSumOverflow = errors.Join(Err, errors.New("sum overflow"))
+
+// Sum returns an Observable that emits the sum of all values emitted by the
+// source Observable when it completes. The sum of an empty Observable is 0.
+// For integer types, an ErrSumOverflow error is emitted instead of silently
+// wrapping around when the sum overflows.
+func Sum[T Integer | Float](observable Observable[T]) Observable[T] {
+	return ReduceE(observable, 0, add[T]).AutoUnsubscribe()
+}
+
+func add[T Integer | Float](sum T, next T) (T, error) {
+	total := sum + next
+	if (next > 0 && total < sum) || (next < 0 && total > sum) {
+		return sum, ErrSumOverflow
+	}
+	return total, nil
+}
@@ -0,0 +1,28 @@
+package rx
+
+import "sync"
+
+// SwitchScan applies an asynchronous accumulator to every value emitted by the
+// source Observable, like MergeScan, but when the source emits a new value the
+// accumulator Observable of the previous value is unsubscribed. The new
+// accumulator is called with the latest value that was accumulated so far.
+func SwitchScan[T, U any](observable Observable[T], seed U, accumulator func(acc U, next T) Observable[U]) Observable[U] {
+	return func(observe Observer[U], scheduler Scheduler, subscriber Subscriber) {
+		var state struct {
+			sync.Mutex
+			acc U
+		}
+		state.acc = seed
+		project := func(next T) Observable[U] {
+			state.Lock()
+			acc := state.acc
+			state.Unlock()
+			return accumulator(acc, next).Do(func(next U) {
+				state.Lock()
+				state.acc = next
+				state.Unlock()
+			})
+		}
+		SwitchMap(observable, project)(observe, scheduler, subscriber)
+	}
+}
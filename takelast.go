@@ -0,0 +1,33 @@
+package rx
+
+// TakeLast returns an Observable that emits only the last n values emitted by
+// the source Observable. The values are buffered and emitted when the source
+// completes. When the source emits an error, only the error is emitted.
+func TakeLast[T any](n int) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		if n <= 0 {
+			return Filter[T](func(T) bool { return false })(observable)
+		}
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			buffer := make([]T, 0, n)
+			observable(func(next T, err error, done bool) {
+				switch {
+				case !done:
+					if len(buffer) == n {
+						buffer = buffer[1:]
+					}
+					buffer = append(buffer, next)
+				case err != nil:
+					observe(next, err, true)
+				default:
+					From(buffer...)(observe, scheduler, subscriber)
+				}
+			}, scheduler, subscriber)
+		}
+	}
+}
+
+// TakeLast emits only the last n values emitted by the Observable.
+func (observable Observable[T]) TakeLast(n int) Observable[T] {
+	return TakeLast[T](n)(observable)
+}
@@ -0,0 +1,44 @@
+package rx
+
+import "sync"
+
+// TakeUntil returns an Observable that mirrors the source Observable until the
+// notifier Observable emits its first value. At that moment it completes and
+// unsubscribes from both the source and the notifier. When the notifier emits
+// an error, that error is emitted. When the notifier completes without
+// emitting a value, the source is mirrored until it terminates.
+func TakeUntil[T, U any](notifier Observable[U]) Pipe[T] {
+	return func(observable Observable[T]) Observable[T] {
+		return Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			var take struct {
+				sync.Mutex
+				done bool
+			}
+			observer := func(next T, err error, done bool) {
+				take.Lock()
+				defer take.Unlock()
+				if !take.done {
+					take.done = done
+					observe(next, err, done)
+				}
+			}
+			notified := subscriber.Add()
+			notifier(func(next U, err error, done bool) {
+				if !done || err != nil {
+					var zero T
+					observer(zero, err, true)
+				}
+				notified.Unsubscribe()
+			}, scheduler, notified)
+			if subscriber.Subscribed() {
+				observable(observer, scheduler, subscriber)
+			}
+		}).AutoUnsubscribe()
+	}
+}
+
+// TakeUntil mirrors the values emitted by the Observable until the notifier
+// emits its first value.
+func (observable Observable[T]) TakeUntil(notifier Observable[any]) Observable[T] {
+	return TakeUntil[T](notifier)(observable)
+}
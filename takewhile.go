@@ -1,20 +1,50 @@
 package rx
 
-func TakeWhile[T any](condition func(T) bool) Pipe[T] {
+// TakeWhileOption is a function type used for configuring whether TakeWhile
+// emits the value that failed its condition.
+type TakeWhileOption func(*takeWhileOptions)
+
+type takeWhileOptions struct {
+	inclusive bool
+}
+
+// WithInclusive creates a TakeWhileOption that makes TakeWhile emit the value
+// that failed the condition before completing.
+func WithInclusive() TakeWhileOption {
+	return func(options *takeWhileOptions) {
+		options.inclusive = true
+	}
+}
+
+// TakeWhile returns an Observable that mirrors the source Observable as long
+// as the condition returns true for the values it emits. When the condition
+// returns false, the Observable completes. When the WithInclusive option is
+// passed, the value that failed the condition is emitted before completing.
+func TakeWhile[T any](condition func(T) bool, options ...TakeWhileOption) Pipe[T] {
+	var opts takeWhileOptions
+	for _, option := range options {
+		option(&opts)
+	}
 	return func(observable Observable[T]) Observable[T] {
-		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		return Observable[T](func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
 			observable(func(next T, err error, done bool) {
 				if done || condition(next) {
 					observe(next, err, done)
 				} else {
+					if opts.inclusive {
+						observe(next, nil, false)
+					}
 					var zero T
 					observe(zero, nil, true)
 				}
 			}, scheduler, subscriber)
-		}
+		}).AutoUnsubscribe()
 	}
 }
 
-func (observable Observable[T]) TakeWhile(condition func(T) bool) Observable[T] {
-	return TakeWhile[T](condition)(observable)
+// TakeWhile mirrors the values emitted by the Observable as long as the
+// condition returns true. When the WithInclusive option is passed, the value
+// that failed the condition is emitted before completing.
+func (observable Observable[T]) TakeWhile(condition func(T) bool, options ...TakeWhileOption) Observable[T] {
+	return TakeWhile[T](condition, options...)(observable)
 }
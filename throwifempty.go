@@ -0,0 +1,39 @@
+package rx
+
+import "errors"
+
+// ErrEmpty is emitted by ThrowIfEmpty when no other error was passed to it. It
+// allows telling apart an Observable that completed without values from one
+// that failed, e.g. when calling First or Last.
+var ErrEmpty = errors.Join(Err, errors.New("empty"))
+
+// ThrowIfEmpty returns an Observable that mirrors the source Observable, but
+// emits err when the source completes without emitting any values. If err is
+// nil, ErrEmpty is emitted instead.
+func ThrowIfEmpty[T any](err error) Pipe[T] {
+	if err == nil {
+		err = ErrEmpty
+	}
+	return func(observable Observable[T]) Observable[T] {
+		return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+			empty := true
+			observable(func(next T, e error, done bool) {
+				switch {
+				case !done:
+					empty = false
+					observe(next, nil, false)
+				case e == nil && empty:
+					observe(next, err, true)
+				default:
+					observe(next, e, true)
+				}
+			}, scheduler, subscriber)
+		}
+	}
+}
+
+// ThrowIfEmpty mirrors the Observable, but emits err when it completes without
+// emitting any values. If err is nil, ErrEmpty is emitted instead.
+func (observable Observable[T]) ThrowIfEmpty(err error) Observable[T] {
+	return ThrowIfEmpty[T](err)(observable)
+}
@@ -0,0 +1,30 @@
+package rx
+
+import "time"
+
+// Elapsed is a value emitted by an Observable together with the time that
+// elapsed since the previous value was emitted.
+type Elapsed[T any] struct {
+	Value   T
+	Elapsed time.Duration
+}
+
+// TimeInterval returns an Observable that wraps every value emitted by the
+// source Observable in an Elapsed, recording the scheduler's time that elapsed
+// since the previous value was emitted. For the first value the time elapsed
+// since the subscription is recorded.
+func TimeInterval[T any](observable Observable[T]) Observable[Elapsed[T]] {
+	return func(observe Observer[Elapsed[T]], scheduler Scheduler, subscriber Subscriber) {
+		previous := scheduler.Now()
+		observable(func(next T, err error, done bool) {
+			if !done {
+				now := scheduler.Now()
+				observe(Elapsed[T]{next, now.Sub(previous)}, nil, false)
+				previous = now
+			} else {
+				var zero Elapsed[T]
+				observe(zero, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
@@ -0,0 +1,53 @@
+package rx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reactivego/rx"
+)
+
+func TestTimeInterval(t *testing.T) {
+	const (
+		ms        = time.Millisecond
+		tolerance = 250 * ms
+	)
+	delays := []time.Duration{20 * ms, 60 * ms, 40 * ms}
+	steps := rx.Concat(rx.Of(0).Delay(delays[0]), rx.Of(1).Delay(delays[1]), rx.Of(2).Delay(delays[2]))
+
+	t.Run("Elapsed since previous", func(t *testing.T) {
+		intervals, err := rx.TimeInterval(steps).Slice()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(intervals) != len(delays) {
+			t.Fatalf("expected %d intervals, got %d", len(delays), len(intervals))
+		}
+		for i, interval := range intervals {
+			if interval.Value != i {
+				t.Fatalf("expected value %d, got %d", i, interval.Value)
+			}
+			if interval.Elapsed < delays[i] || interval.Elapsed > delays[i]+tolerance {
+				t.Fatalf("value %d: expected elapsed %v (+%v), got %v", i, delays[i], tolerance, interval.Elapsed)
+			}
+		}
+	})
+
+	t.Run("Timestamp relative to start", func(t *testing.T) {
+		start := time.Now()
+		stamps, err := rx.Timestamp(steps).Slice()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stamps) != len(delays) {
+			t.Fatalf("expected %d timestamps, got %d", len(delays), len(stamps))
+		}
+		due := time.Duration(0)
+		for i, stamp := range stamps {
+			due += delays[i]
+			if at := stamp.At.Sub(start); at < due || at > due+tolerance {
+				t.Fatalf("value %d: expected timestamp at %v (+%v), got %v", i, due, tolerance, at)
+			}
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package rx
+
+import "time"
+
+// TimerAt creates an Observable that emits the scheduler's time once the
+// absolute time at has been reached and then completes. When at is in the
+// past, it emits immediately. The due duration is calculated from the
+// scheduler's time at the moment of subscription.
+func TimerAt(at time.Time) Observable[time.Time] {
+	return func(observe Observer[time.Time], scheduler Scheduler, subscriber Subscriber) {
+		runner := scheduler.ScheduleFuture(at.Sub(scheduler.Now()), func() {
+			if subscriber.Subscribed() {
+				observe(scheduler.Now(), nil, false)
+				if subscriber.Subscribed() {
+					var zero time.Time
+					observe(zero, nil, true)
+				}
+			}
+		})
+		subscriber.OnUnsubscribe(runner.Cancel)
+	}
+}
@@ -0,0 +1,26 @@
+package rx
+
+import "time"
+
+// Timestamped is a value emitted by an Observable together with the time at
+// which it was emitted.
+type Timestamped[T any] struct {
+	Value T
+	At    time.Time
+}
+
+// Timestamp returns an Observable that wraps every value emitted by the source
+// Observable in a Timestamped, recording the scheduler's time at which the
+// value was emitted.
+func Timestamp[T any](observable Observable[T]) Observable[Timestamped[T]] {
+	return func(observe Observer[Timestamped[T]], scheduler Scheduler, subscriber Subscriber) {
+		observable(func(next T, err error, done bool) {
+			if !done {
+				observe(Timestamped[T]{next, scheduler.Now()}, nil, false)
+			} else {
+				var zero Timestamped[T]
+				observe(zero, err, true)
+			}
+		}, scheduler, subscriber)
+	}
+}
@@ -0,0 +1,27 @@
+package rx
+
+// ToMap returns an Observable that collects the values emitted by the source
+// Observable into a map and emits it when the source completes. The key and
+// value functions derive the entry for every value, a later value replaces an
+// earlier value with the same key.
+func ToMap[T any, K comparable, V any](observable Observable[T], key func(T) K, value func(T) V) Observable[map[K]V] {
+	return func(observe Observer[map[K]V], scheduler Scheduler, subscriber Subscriber) {
+		entries := make(map[K]V)
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				entries[key(next)] = value(next)
+			case err != nil:
+				observe(nil, err, true)
+			default:
+				Of(entries)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
+
+// CollectMap subscribes to the Observable and waits for it to complete,
+// returning the values collected into a map as ToMap does.
+func CollectMap[T any, K comparable, V any](observable Observable[T], key func(T) K, value func(T) V, schedulers ...Scheduler) (map[K]V, error) {
+	return ToMap(observable, key, value).First(schedulers...)
+}
@@ -0,0 +1,28 @@
+package rx
+
+// ToMultiMap returns an Observable that collects the values emitted by the
+// source Observable into a map of slices and emits it when the source
+// completes. Values with the same key are appended in the order they were
+// emitted.
+func ToMultiMap[T any, K comparable, V any](observable Observable[T], key func(T) K, value func(T) V) Observable[map[K][]V] {
+	return func(observe Observer[map[K][]V], scheduler Scheduler, subscriber Subscriber) {
+		entries := make(map[K][]V)
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				k := key(next)
+				entries[k] = append(entries[k], value(next))
+			case err != nil:
+				observe(nil, err, true)
+			default:
+				Of(entries)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
+
+// CollectMultiMap subscribes to the Observable and waits for it to complete,
+// returning the values collected into a map of slices as ToMultiMap does.
+func CollectMultiMap[T any, K comparable, V any](observable Observable[T], key func(T) K, value func(T) V, schedulers ...Scheduler) (map[K][]V, error) {
+	return ToMultiMap(observable, key, value).First(schedulers...)
+}
@@ -0,0 +1,60 @@
+package rx
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// TopK returns an Observable that emits, when the source Observable completes,
+// a slice with the k values that sort first according to less, in sorted
+// order. Only k values are kept in memory, using a heap, so TopK can be used
+// on streams of any length. To get the k largest values, pass a less function
+// that reports whether a is greater than b.
+func TopK[T any](observable Observable[T], k int, less func(a, b T) bool) Observable[[]T] {
+	if k < 0 {
+		return Throw[[]T](ErrInvalidCount)
+	}
+	return func(observe Observer[[]T], scheduler Scheduler, subscriber Subscriber) {
+		top := &topK[T]{less: less}
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				if len(top.items) < k {
+					heap.Push(top, next)
+				} else if k > 0 && less(next, top.items[0]) {
+					top.items[0] = next
+					heap.Fix(top, 0)
+				}
+			case err != nil:
+				observe(nil, err, true)
+			default:
+				items := top.items
+				sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+				Of(items)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
+
+// CollectTopK subscribes to the Observable and waits for it to complete,
+// returning the k values that sort first according to less as TopK does.
+func CollectTopK[T any](observable Observable[T], k int, less func(a, b T) bool, schedulers ...Scheduler) ([]T, error) {
+	return TopK(observable, k, less).First(schedulers...)
+}
+
+// topK is a heap that keeps the value that sorts last at the root, so it can
+// be replaced when a value arrives that sorts before it.
+type topK[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *topK[T]) Len() int           { return len(h.items) }
+func (h *topK[T]) Less(i, j int) bool { return h.less(h.items[j], h.items[i]) }
+func (h *topK[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *topK[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+func (h *topK[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -0,0 +1,25 @@
+package rx
+
+// ToSet returns an Observable that collects the distinct values emitted by the
+// source Observable into a set and emits it when the source completes.
+func ToSet[T comparable](observable Observable[T]) Observable[map[T]struct{}] {
+	return func(observe Observer[map[T]struct{}], scheduler Scheduler, subscriber Subscriber) {
+		set := make(map[T]struct{})
+		observable(func(next T, err error, done bool) {
+			switch {
+			case !done:
+				set[next] = struct{}{}
+			case err != nil:
+				observe(nil, err, true)
+			default:
+				Of(set)(observe, scheduler, subscriber)
+			}
+		}, scheduler, subscriber)
+	}
+}
+
+// CollectSet subscribes to the Observable and waits for it to complete,
+// returning the distinct values collected into a set as ToSet does.
+func CollectSet[T comparable](observable Observable[T], schedulers ...Scheduler) (map[T]struct{}, error) {
+	return ToSet(observable).First(schedulers...)
+}
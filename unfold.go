@@ -0,0 +1,29 @@
+package rx
+
+// Unfold creates an Observable from a seed state and an unfold function. The
+// unfold function is called with the current state and returns the next value
+// to emit, the next state and whether to continue. When it returns false, the
+// Observable completes without emitting that value. Every subscription starts
+// again from the seed.
+func Unfold[S, T any](seed S, unfold func(S) (T, S, bool)) Observable[T] {
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		state := seed
+		task := func(again func()) {
+			if subscriber.Subscribed() {
+				var next T
+				var ok bool
+				if next, state, ok = unfold(state); ok {
+					observe(next, nil, false)
+					if subscriber.Subscribed() {
+						again()
+					}
+				} else {
+					var zero T
+					observe(zero, nil, true)
+				}
+			}
+		}
+		runner := scheduler.ScheduleRecursive(task)
+		subscriber.OnUnsubscribe(runner.Cancel)
+	}
+}
@@ -0,0 +1,44 @@
+package rx
+
+// Unzip2 splits an Observable of Tuple2 values into an Observable for each
+// field of the tuple, reversing Zip2. All Observables complete or error along
+// with the source. The source is subscribed to only once, when all the
+// Observables have been subscribed to, see Partition for the details.
+func Unzip2[T, U any](source Observable[Tuple2[T, U]]) (Observable[T], Observable[U]) {
+	shared := fanOut(source, 2)
+	return Map(shared, func(next Tuple2[T, U]) T { return next.First }),
+		Map(shared, func(next Tuple2[T, U]) U { return next.Second })
+}
+
+// Unzip3 splits an Observable of Tuple3 values into an Observable for each
+// field of the tuple, reversing Zip3. See Partition for how the source is
+// shared.
+func Unzip3[T, U, V any](source Observable[Tuple3[T, U, V]]) (Observable[T], Observable[U], Observable[V]) {
+	shared := fanOut(source, 3)
+	return Map(shared, func(next Tuple3[T, U, V]) T { return next.First }),
+		Map(shared, func(next Tuple3[T, U, V]) U { return next.Second }),
+		Map(shared, func(next Tuple3[T, U, V]) V { return next.Third })
+}
+
+// Unzip4 splits an Observable of Tuple4 values into an Observable for each
+// field of the tuple, reversing Zip4. See Partition for how the source is
+// shared.
+func Unzip4[T, U, V, W any](source Observable[Tuple4[T, U, V, W]]) (Observable[T], Observable[U], Observable[V], Observable[W]) {
+	shared := fanOut(source, 4)
+	return Map(shared, func(next Tuple4[T, U, V, W]) T { return next.First }),
+		Map(shared, func(next Tuple4[T, U, V, W]) U { return next.Second }),
+		Map(shared, func(next Tuple4[T, U, V, W]) V { return next.Third }),
+		Map(shared, func(next Tuple4[T, U, V, W]) W { return next.Fourth })
+}
+
+// Unzip5 splits an Observable of Tuple5 values into an Observable for each
+// field of the tuple, reversing Zip5. See Partition for how the source is
+// shared.
+func Unzip5[T, U, V, W, X any](source Observable[Tuple5[T, U, V, W, X]]) (Observable[T], Observable[U], Observable[V], Observable[W], Observable[X]) {
+	shared := fanOut(source, 5)
+	return Map(shared, func(next Tuple5[T, U, V, W, X]) T { return next.First }),
+		Map(shared, func(next Tuple5[T, U, V, W, X]) U { return next.Second }),
+		Map(shared, func(next Tuple5[T, U, V, W, X]) V { return next.Third }),
+		Map(shared, func(next Tuple5[T, U, V, W, X]) W { return next.Fourth }),
+		Map(shared, func(next Tuple5[T, U, V, W, X]) X { return next.Fifth })
+}
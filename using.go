@@ -0,0 +1,21 @@
+package rx
+
+// Using creates an Observable whose lifetime is tied to a resource. On every
+// subscription the resourceFactory is called to create a resource, which is
+// then passed to observableFactory to create the Observable to subscribe to.
+// The dispose function is called exactly once with the resource when the
+// subscription completes, errors or is unsubscribed.
+//
+// When resourceFactory returns an error, the returned Observable emits that
+// error and dispose is not called. This is useful for files, database cursors
+// or sockets that are opened per subscription and must always be closed.
+func Using[R, T any](resourceFactory func() (R, error), observableFactory func(R) Observable[T], dispose func(R)) Observable[T] {
+	return func(observe Observer[T], scheduler Scheduler, subscriber Subscriber) {
+		resource, err := resourceFactory()
+		if err != nil {
+			Throw[T](err)(observe, scheduler, subscriber)
+			return
+		}
+		observableFactory(resource).Finalize(func() { dispose(resource) })(observe, scheduler, subscriber)
+	}
+}